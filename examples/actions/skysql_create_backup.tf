@@ -0,0 +1,11 @@
+# Take a full backup before upgrading a service, without tracking the backup
+# in state:
+#   terraform apply -invoke=action.skysql_create_backup.before_upgrade
+# Actions require Terraform 1.14 or later.
+action "skysql_create_backup" "before_upgrade" {
+  config {
+    service_id  = skysql_service.default.id
+    name        = "before-upgrade"
+    backup_type = "full"
+  }
+}
@@ -0,0 +1,20 @@
+# Restart a service node by node whenever its configuration values change.
+# Actions require Terraform 1.14 or later.
+action "skysql_restart_service" "apply_config" {
+  config {
+    service_id = skysql_service.default.id
+    rolling    = true
+    timeout    = "90m"
+  }
+}
+
+resource "terraform_data" "config_values" {
+  input = skysql_config.tuned.values
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.skysql_restart_service.apply_config]
+    }
+  }
+}
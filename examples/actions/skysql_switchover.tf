@@ -0,0 +1,9 @@
+# Make the replica the primary as part of a runbook:
+#   terraform apply -invoke=action.skysql_switchover.to_replica
+# Actions require Terraform 1.14 or later.
+action "skysql_switchover" "to_replica" {
+  config {
+    service_id         = skysql_service.replica.id
+    primary_service_id = skysql_service.primary.id
+  }
+}
@@ -0,0 +1,12 @@
+# List the successful full backups of a service taken in January 2024.
+data "skysql_backups" "default" {
+  service_id     = "dbpwf22338686"
+  status         = "succeeded"
+  backup_type    = "full"
+  started_after  = "2024-01-01T00:00:00Z"
+  started_before = "2024-02-01T00:00:00Z"
+}
+
+output "backup_ids" {
+  value = data.skysql_backups.default.backups[*].id
+}
@@ -58,6 +58,18 @@ resource "skysql_service" "replica" {
   # if you want to wait for the service to be created set wait_for_creation to true
   wait_for_creation = true
   wait_for_deletion = true
+  # Do not finish the apply until replication from the primary is running,
+  # so modules that depend on the replica only start once it is usable.
+  wait_for_replication_healthy = true
+}
+
+# Show the replication health of the replica
+output "replica_replication" {
+  value = {
+    state       = skysql_service.replica.replication_state
+    lag_seconds = skysql_service.replica.replication_lag_seconds
+    last_error  = skysql_service.replica.replication_last_error
+  }
 }
 
 # Retrieve the service default credentials.
@@ -101,4 +113,29 @@ output "skysql_cmd" {
   value = "mariadb --host ${data.skysql_service.default.fqdn} --port 3306 --user ${data.skysql_service.default.service_id} -p --ssl-verify-server-cert"
 }
 
-
+# The skysql_global_cluster resource manages a primary and replicas in several
+# regions as one unit, if you prefer not to wire the services together yourself.
+# It replaces both services above:
+#
+# resource "skysql_global_cluster" "default" {
+#   project_id = data.skysql_projects.default.projects[0].id
+#   topology   = "xpand"
+#   version    = local.sky_versions_filtered[0].name
+#
+#   primary = {
+#     name           = "my-primary-service"
+#     cloud_provider = "gcp"
+#     region         = "us-central1"
+#     size           = "sky-2x8"
+#     storage        = 100
+#   }
+#
+#   replicas = [
+#     {
+#       name           = "my-replica-service"
+#       cloud_provider = "gcp"
+#       region         = "europe-west1"
+#       size           = "sky-2x8"
+#     },
+#   ]
+# }
@@ -42,7 +42,7 @@ data "aws_subnets" "this" {
 
 locals {
   # this should work for all topologies other than lakehouse
-  readwrite_port = [for p in data.skysql_service.this.endpoints[0].ports : p.port if p.purpose == "readwrite"][0]
+  readwrite_port = skysql_service.this.read_write_port
 }
 
 ###
@@ -12,3 +12,8 @@ output "vpc_endpoint_id" {
   description = "AWS privatelink endpoint id"
   value       = aws_vpc_endpoint.this.id
 }
+
+output "skysql_connection_uri" {
+  description = "SkySQL connection URI for the read-write port, without credentials"
+  value       = skysql_service.this.connection_uri
+}
@@ -0,0 +1,18 @@
+# Take an on-demand backup before a risky change, such as a version upgrade
+# or a configuration swap. Terraform waits for the backup to complete unless
+# wait_for_completion = false.
+#
+# Destroying the resource deletes the backup.
+resource "skysql_backup" "before_upgrade" {
+  service_id  = skysql_service.default.id
+  name        = "before-upgrade"
+  backup_type = "full"
+
+  timeouts {
+    create = "90m"
+  }
+}
+
+output "backup_id" {
+  value = skysql_backup.before_upgrade.id
+}
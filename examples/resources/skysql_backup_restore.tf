@@ -0,0 +1,21 @@
+# Roll back a bad migration by restoring a backup into the same service.
+# The current data of the service is replaced. Change any value in triggers
+# to run the restore again.
+#
+# Destroying the resource only removes it from the Terraform state.
+resource "skysql_backup_restore" "rollback" {
+  service_id = skysql_service.default.id
+  backup_id  = skysql_backup.before_upgrade.id
+
+  triggers = {
+    migration = "0042_add_orders_index"
+  }
+
+  timeouts {
+    create = "120m"
+  }
+}
+
+output "restore_status" {
+  value = skysql_backup_restore.rollback.status
+}
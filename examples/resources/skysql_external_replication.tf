@@ -0,0 +1,22 @@
+# Replicate into a SkySQL service from a self-managed MariaDB server, for
+# example during a migration. Set enabled = false to pause the replication
+# without losing its configuration.
+#
+# Destroying the resource stops the replication and resets its configuration.
+resource "skysql_external_replication" "migration" {
+  service_id    = skysql_service.default.id
+  host          = "db.example.com"
+  port          = 3306
+  username      = "repl"
+  password      = var.replication_password
+  gtid_position = "0-1-100"
+
+  ssl_enabled                   = true
+  ssl_ca_certificate            = file("ca.pem")
+  ssl_verify_server_certificate = true
+}
+
+variable "replication_password" {
+  type      = string
+  sensitive = true
+}
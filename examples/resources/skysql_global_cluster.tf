@@ -0,0 +1,39 @@
+# A primary in us-central1 with read replicas in Europe and Asia. Replicas are
+# created in order after the primary is ready, and the primary's outbound IPs
+# are added to every replica's allow list.
+#
+# Add or remove entries in replicas to change the replica regions in place.
+resource "skysql_global_cluster" "orders" {
+  topology = "es-replica"
+  version  = "10.6.11-6-1"
+
+  primary = {
+    name           = "orders"
+    cloud_provider = "gcp"
+    region         = "us-central1"
+    size           = "sky-2x8"
+    storage        = 200
+  }
+
+  replicas = [
+    {
+      name           = "orders-eu"
+      cloud_provider = "gcp"
+      region         = "europe-west1"
+      size           = "sky-2x8"
+    },
+    {
+      name           = "orders-asia"
+      cloud_provider = "gcp"
+      region         = "asia-east1"
+      size           = "sky-2x4"
+    },
+  ]
+
+  # Set to false before destroying the cluster.
+  deletion_protection = true
+}
+
+output "global_cluster_endpoints" {
+  value = skysql_global_cluster.orders.endpoints
+}
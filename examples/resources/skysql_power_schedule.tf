@@ -0,0 +1,21 @@
+# Stop a development service in the evening and start it in the morning on
+# working days. The service ignores the power changes made by the schedule.
+resource "skysql_service" "dev" {
+  service_type      = "transactional"
+  topology          = "es-single"
+  cloud_provider    = "gcp"
+  region            = "us-central1"
+  name              = "dev"
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  wait_for_creation = true
+  ignore_is_active  = true
+}
+
+resource "skysql_power_schedule" "dev" {
+  service_id     = skysql_service.dev.id
+  stop_schedule  = "0 20 * * 1-5"
+  start_schedule = "0 7 * * 1-5"
+  timezone       = "Europe/Berlin"
+}
@@ -0,0 +1,32 @@
+# Connect to a SkySQL service on AWS over PrivateLink. The allowed accounts are
+# validated at plan time: 12-digit account IDs on aws, project IDs or project
+# numbers on gcp and subscription IDs on azure.
+#
+# Destroying the resource removes the private endpoint from the service.
+resource "skysql_private_endpoint" "this" {
+  service_id       = skysql_service.default.id
+  cloud_provider   = skysql_service.default.cloud_provider
+  allowed_accounts = [data.aws_caller_identity.current.account_id]
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc_endpoint" "skysql" {
+  vpc_id             = var.vpc_id
+  service_name       = skysql_private_endpoint.this.aws_vpc_endpoint_service_name
+  vpc_endpoint_type  = "Interface"
+  subnet_ids         = var.subnet_ids
+  security_group_ids = var.security_group_ids
+}
+
+variable "vpc_id" {
+  type = string
+}
+
+variable "subnet_ids" {
+  type = list(string)
+}
+
+variable "security_group_ids" {
+  type = list(string)
+}
@@ -0,0 +1,16 @@
+# Promote a replica during a DR drill. Use mode = "switchover" for a planned
+# role swap where the former primary replicates from the promoted service.
+# Change any value in triggers to run the promotion again.
+#
+# Destroying the resource only removes it from the Terraform state. The
+# skysql_service resources keep the replication_enabled and primary_host they
+# were created with, so the promotion does not replace them.
+resource "skysql_replication_promotion" "dr_drill" {
+  service_id         = skysql_service.replica.id
+  primary_service_id = skysql_service.primary.id
+  mode               = "switchover"
+
+  triggers = {
+    drill = "2024-q3"
+  }
+}
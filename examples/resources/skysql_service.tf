@@ -24,6 +24,18 @@ resource "skysql_service" "default" {
   wait_for_creation = true
   # Optional: apply a custom configuration object (requires wait_for_creation = true)
   # config_id = skysql_config.tuned.id
+  # Optional: only apply patches and restarts outside of peak hours.
+  maintenance_window = {
+    day_of_week    = "sunday"
+    start_hour     = 2
+    duration_hours = 4
+    timezone       = "America/New_York"
+  }
+  # Optional: take a full backup before the service is destroyed. The service
+  # is only deleted once the backup has succeeded.
+  # final_backup                = true
+  # final_backup_name           = "myservice-final"
+  # final_backup_retention_days = 90
 }
 
 # Create a Galera cluster (multi-master, high availability)
@@ -48,4 +60,114 @@ resource "skysql_service" "galera" {
   # (see the skysql_versions data source).
   version           = "10.6.11-6-1"
   wait_for_creation = true
-}
\ No newline at end of file
+}
+# Create a staging service seeded from a production backup.
+# The service is provisioned first and the backup is then restored into it.
+# The backup must come from a service with the same topology and the same
+# major and minor server version. Changing backup_id recreates the service.
+resource "skysql_service" "staging" {
+  project_id        = data.skysql_projects.default.projects[0].id
+  service_type      = "transactional"
+  topology          = "es-single"
+  cloud_provider    = "aws"
+  region            = "us-east-1"
+  name              = "mystaging"
+  architecture      = "amd64"
+  nodes             = 1
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  version           = skysql_service.default.version
+  volume_type       = "gp3"
+  volume_iops       = 3000
+  volume_throughput = 125
+  wait_for_creation = true
+  restore_from = {
+    backup_id = skysql_backup.before_upgrade.id
+  }
+}
+
+# Expose a service through a public endpoint for the office and a private
+# endpoint for an application VPC. Each endpoint has its own mechanism and
+# access rules, and endpoints can be added or removed without recreating the service.
+resource "skysql_service" "multi_endpoint" {
+  project_id        = data.skysql_projects.default.projects[0].id
+  service_type      = "transactional"
+  topology          = "es-single"
+  cloud_provider    = "aws"
+  region            = "us-east-1"
+  name              = "mymultiendpoint"
+  architecture      = "amd64"
+  nodes             = 1
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  version           = data.skysql_versions.default.versions[0].name
+  volume_type       = "gp3"
+  volume_iops       = 3000
+  volume_throughput = 125
+  wait_for_creation = true
+  endpoints = [
+    {
+      name      = "primary"
+      mechanism = "nlb"
+      allow_list = [
+        {
+          ip      = "203.0.113.0/24"
+          comment = "office"
+        }
+      ]
+    },
+    {
+      name             = "private"
+      mechanism        = "privateconnect"
+      allowed_accounts = ["123456789012"]
+    }
+  ]
+}
+
+# Encrypt the storage of a service with your own AWS KMS key. GCP services take a
+# Cloud KMS key name and Azure services a Key Vault key URI. Changing the key
+# recreates the service.
+resource "skysql_service" "encrypted" {
+  project_id        = data.skysql_projects.default.projects[0].id
+  service_type      = "transactional"
+  topology          = "es-single"
+  cloud_provider    = "aws"
+  region            = "us-east-1"
+  name              = "myencrypted"
+  architecture      = "amd64"
+  nodes             = 1
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  version           = data.skysql_versions.default.versions[0].name
+  volume_type       = "gp3"
+  volume_iops       = 3000
+  volume_throughput = 125
+  wait_for_creation = true
+  encryption = {
+    kms_key_id = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+  }
+}
+
+# Keep a serverless development database cheap: cap its capacity and pause it
+# after an hour without activity. The settings are updated in place.
+resource "skysql_service" "dev" {
+  project_id        = data.skysql_projects.default.projects[0].id
+  service_type      = "transactional"
+  topology          = "serverless-standalone"
+  cloud_provider    = "aws"
+  region            = "us-east-1"
+  name              = "mydev"
+  ssl_enabled       = true
+  size              = "sky-2x8"
+  storage           = 100
+  volume_type       = "gp3"
+  wait_for_creation = true
+  serverless = {
+    min_capacity             = 1
+    max_capacity             = 4
+    auto_pause_after_minutes = 60
+  }
+}
@@ -0,0 +1,16 @@
+# Clone a production service as of a point in time, for example to investigate
+# an incident. The clone inherits the settings of the source service; size,
+# topology and version can be overridden.
+#
+# Destroying the resource deletes the clone.
+resource "skysql_service_clone" "incident" {
+  source_service_id   = skysql_service.default.id
+  point_in_time       = "2024-05-14T09:30:00Z"
+  name                = "incident-copy"
+  size                = "sky-2x8"
+  deletion_protection = false
+}
+
+output "clone_fqdn" {
+  value = skysql_service_clone.incident.fqdn
+}
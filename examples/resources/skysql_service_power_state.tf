@@ -0,0 +1,25 @@
+# Stop a development service outside of working hours without planning the
+# whole service, for example from a nightly pipeline that sets
+# TF_VAR_dev_active=false. The service leaves its power state to this resource.
+resource "skysql_service" "dev" {
+  service_type      = "transactional"
+  topology          = "es-single"
+  cloud_provider    = "gcp"
+  region            = "us-central1"
+  name              = "dev"
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  wait_for_creation = true
+  ignore_is_active  = true
+}
+
+resource "skysql_service_power_state" "dev" {
+  service_id = skysql_service.dev.id
+  active     = var.dev_active
+}
+
+variable "dev_active" {
+  type    = bool
+  default = true
+}
@@ -0,0 +1,36 @@
+# Restart a service, node by node, whenever the values of its configuration
+# change, so that variables that require a restart take effect.
+resource "skysql_config" "tuned" {
+  name          = "my-tuned-config"
+  topology      = "es-replica"
+  version       = "10.6.7-3-1"
+  allow_restart = true
+
+  values = {
+    "innodb_buffer_pool_size" = "2G"
+  }
+}
+
+resource "skysql_service" "default" {
+  service_type      = "transactional"
+  topology          = "es-replica"
+  cloud_provider    = "aws"
+  region            = "us-east-1"
+  name              = "myservice"
+  nodes             = 2
+  size              = "sky-2x8"
+  storage           = 100
+  ssl_enabled       = true
+  version           = "10.6.7-3-1"
+  wait_for_creation = true
+  config_id         = skysql_config.tuned.id
+}
+
+resource "skysql_service_restart" "default" {
+  service_id = skysql_service.default.id
+  rolling    = true
+
+  triggers = {
+    config_values = jsonencode(skysql_config.tuned.values)
+  }
+}
@@ -0,0 +1,50 @@
+# Peer the SkySQL network of a region with your own VPC on AWS, then accept the
+# peering and route the SkySQL CIDR through it on your side.
+#
+# Every argument forces a new peering.
+resource "skysql_vpc_peering" "this" {
+  name            = "analytics"
+  cloud_provider  = "aws"
+  region          = "us-east-2"
+  peer_vpc_id     = var.vpc_id
+  peer_account_id = data.aws_caller_identity.current.account_id
+  peer_cidr       = "10.0.0.0/16"
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc_peering_connection_accepter" "skysql" {
+  vpc_peering_connection_id = skysql_vpc_peering.this.aws_peering_connection_id
+  auto_accept               = true
+}
+
+resource "aws_route" "skysql" {
+  route_table_id            = var.route_table_id
+  destination_cidr_block    = skysql_vpc_peering.this.skysql_cidr
+  vpc_peering_connection_id = aws_vpc_peering_connection_accepter.skysql.id
+}
+
+# On GCP, create the other half of the peering with google_compute_network_peering:
+#
+# resource "skysql_vpc_peering" "gcp" {
+#   name            = "analytics"
+#   cloud_provider  = "gcp"
+#   region          = "us-central1"
+#   peer_network    = "my-network"
+#   peer_project_id = "my-project"
+#   peer_cidr       = "10.0.0.0/16"
+# }
+#
+# resource "google_compute_network_peering" "skysql" {
+#   name         = "skysql"
+#   network      = "projects/my-project/global/networks/my-network"
+#   peer_network = skysql_vpc_peering.gcp.skysql_network_self_link
+# }
+
+variable "vpc_id" {
+  type = string
+}
+
+variable "route_table_id" {
+  type = string
+}
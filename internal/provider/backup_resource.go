@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+)
+
+const defaultBackupTimeout = 60 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupResource{}
+var _ resource.ResourceWithImportState = &BackupResource{}
+var _ resource.ResourceWithConfigure = &BackupResource{}
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the resource implementation.
+type BackupResource struct {
+	client *skysql.Client
+}
+
+// BackupResourceModel describes the resource data model.
+type BackupResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ServiceID         types.String   `tfsdk:"service_id"`
+	Name              types.String   `tfsdk:"name"`
+	BackupType        types.String   `tfsdk:"backup_type"`
+	Status            types.String   `tfsdk:"status"`
+	SizeBytes         types.Int64    `tfsdk:"size_bytes"`
+	StartedAt         types.String   `tfsdk:"started_at"`
+	CompletedAt       types.String   `tfsdk:"completed_at"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *BackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers an on-demand backup of a service. " +
+			"Destroying the resource deletes the backup.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the backup",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to back up",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A name for the backup. Changing this value forces a new backup to be taken",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(backup.TypeFull),
+				Description: "The type of the backup. Valid values are: full, incremental or snapshot. Default is full",
+				Validators: []validator.String{
+					stringvalidator.OneOf(backup.TypeFull, backup.TypeIncremental, backup.TypeSnapshot),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the backup",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the backup in bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the backup started, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the backup completed, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to wait for the backup to complete. Valid values are: true or false. Default is true",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, err := r.client.CreateBackup(ctx, &backup.CreateBackupRequest{
+		ServiceID: data.ServiceID.ValueString(),
+		Type:      data.BackupType.ValueString(),
+		Name:      data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating backup", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created backup resource", map[string]interface{}{
+		"id":         b.ID,
+		"service_id": b.ServiceID,
+	})
+
+	backupToState(b, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForCompletion.ValueBool() {
+		createTimeout, diags := data.Timeouts.Create(ctx, defaultBackupTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		b, err = waitForBackup(ctx, r.client, b.ID, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating backup", fmt.Sprintf("Backup did not complete: %s", err))
+			return
+		}
+
+		backupToState(b, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, err := r.client.GetBackupByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL backup not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading backup", err.Error())
+		return
+	}
+
+	backupToState(b, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BackupResourceModel
+	var state BackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute that affects the backup itself forces replacement,
+	// so only the provider-side settings can change here.
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBackup(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL backup already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting backup", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted backup resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *BackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), true)...)
+}
+
+func backupToState(b *backup.Backup, data *BackupResourceModel) {
+	data.ID = types.StringValue(b.ID)
+	if b.ServiceID != "" {
+		data.ServiceID = types.StringValue(b.ServiceID)
+	}
+	data.Name = stringValueOrNull(b.Name)
+	data.BackupType = types.StringValue(b.Type)
+	data.Status = types.StringValue(b.Status)
+	data.SizeBytes = types.Int64Value(b.Size)
+	data.StartedAt = timeToString(b.StartTime)
+	data.CompletedAt = timeToString(b.EndTime)
+}
+
+// timeToString formats an optional API timestamp as RFC 3339, or null when it is not set.
+func timeToString(t *time.Time) types.String {
+	if t == nil || t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// waitForBackup polls a backup until it succeeds, fails or the timeout elapses.
+func waitForBackup(ctx context.Context, client *skysql.Client, backupID string, timeout time.Duration) (*backup.Backup, error) {
+	var result *backup.Backup
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		b, err := client.GetBackupByID(ctx, backupID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving backup details: %v", err))
+		}
+
+		switch b.Status {
+		case backup.StatusSucceeded:
+			result = b
+			return nil
+		case backup.StatusFailed:
+			if b.Error != "" {
+				return sdkresource.NonRetryableError(fmt.Errorf("backup %s failed: %s", backupID, b.Error))
+			}
+			return sdkresource.NonRetryableError(fmt.Errorf("backup %s failed", backupID))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected backup to be in succeeded or failed state but was in state %s", b.Status))
+	})
+
+	return result, err
+}
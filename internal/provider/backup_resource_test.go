@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBackupID        = "bkp-abc-123"
+	testBackupServiceID = "dbdgf42002418"
+	testBackupName      = "before-upgrade"
+)
+
+func createBackupResponse(t *testing.T, expectedType string) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/backups", req.URL.Path)
+
+		var payload backup.CreateBackupRequest
+		err := json.NewDecoder(req.Body).Decode(&payload)
+		r.NoError(err)
+		r.Equal(testBackupServiceID, payload.ServiceID)
+		r.Equal(expectedType, payload.Type)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(backup.Backup{
+			ID:        testBackupID,
+			Name:      payload.Name,
+			ServiceID: payload.ServiceID,
+			Type:      payload.Type,
+			Status:    backup.StatusScheduled,
+		})
+	}
+}
+
+func getBackupResponse(t *testing.T, status string) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/backups/"+testBackupID, req.URL.Path)
+
+		started := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		b := backup.Backup{
+			ID:        testBackupID,
+			Name:      testBackupName,
+			ServiceID: testBackupServiceID,
+			Type:      backup.TypeFull,
+			Status:    status,
+			StartTime: &started,
+		}
+		if status == backup.StatusSucceeded {
+			completed := started.Add(10 * time.Minute)
+			b.EndTime = &completed
+			b.Size = 1073741824
+		}
+		if status == backup.StatusFailed {
+			b.Error = "storage quota exceeded"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(b)
+	}
+}
+
+func deleteBackupResponse(t *testing.T) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/skybackup/v1/backups/"+testBackupID, req.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestBackupResource_CreateAndWait(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	// Provider configure
+	expectRequest(versionsResponse(t))
+	// Create: POST /backups
+	expectRequest(createBackupResponse(t, backup.TypeFull))
+	// Create: wait for the backup to complete
+	expectRequest(getBackupResponse(t, backup.StatusInProgress))
+	expectRequest(getBackupResponse(t, backup.StatusSucceeded))
+	// Read after create
+	expectRequest(getBackupResponse(t, backup.StatusSucceeded))
+	// Destroy: delete
+	expectRequest(deleteBackupResponse(t))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "skysql_backup" "test" {
+					service_id = "%s"
+					name       = "before-upgrade"
+				}`, testBackupServiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_backup.test", "id", testBackupID),
+					resource.TestCheckResourceAttr("skysql_backup.test", "service_id", testBackupServiceID),
+					resource.TestCheckResourceAttr("skysql_backup.test", "name", "before-upgrade"),
+					resource.TestCheckResourceAttr("skysql_backup.test", "backup_type", backup.TypeFull),
+					resource.TestCheckResourceAttr("skysql_backup.test", "status", backup.StatusSucceeded),
+					resource.TestCheckResourceAttr("skysql_backup.test", "size_bytes", "1073741824"),
+					resource.TestCheckResourceAttr("skysql_backup.test", "started_at", "2024-01-02T15:04:05Z"),
+					resource.TestCheckResourceAttr("skysql_backup.test", "completed_at", "2024-01-02T15:14:05Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupResource_CreateFailed(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	// Provider configure
+	expectRequest(versionsResponse(t))
+	// Create: POST /backups
+	expectRequest(createBackupResponse(t, backup.TypeSnapshot))
+	// Create: the backup fails
+	expectRequest(getBackupResponse(t, backup.StatusFailed))
+	// Destroy: the partially created backup is tainted and removed
+	expectRequest(deleteBackupResponse(t))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "skysql_backup" "test" {
+					service_id  = "%s"
+					backup_type = "snapshot"
+				}`, testBackupServiceID),
+				ExpectError: regexp.MustCompile("storage quota exceeded"),
+			},
+		},
+	})
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupRestoreResource{}
+var _ resource.ResourceWithConfigure = &BackupRestoreResource{}
+
+func NewBackupRestoreResource() resource.Resource {
+	return &BackupRestoreResource{}
+}
+
+// BackupRestoreResource defines the resource implementation.
+type BackupRestoreResource struct {
+	client *skysql.Client
+}
+
+// BackupRestoreResourceModel describes the resource data model.
+type BackupRestoreResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	ServiceID   types.String   `tfsdk:"service_id"`
+	BackupID    types.String   `tfsdk:"backup_id"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	Status      types.String   `tfsdk:"status"`
+	Error       types.String   `tfsdk:"error"`
+	StartedAt   types.String   `tfsdk:"started_at"`
+	CompletedAt types.String   `tfsdk:"completed_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BackupRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_restore"
+}
+
+func (r *BackupRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a backup into an existing service and waits for the service to be ready again. " +
+			"The restore runs when the resource is created and again whenever triggers change. " +
+			"Destroying the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the restore",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to restore the backup into. The current data of the service is replaced",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the backup to restore",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that, when changed, run the restore again",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The outcome of the restore",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error reported by the restore, if it failed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the restore started, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the restore completed, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *BackupRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore, err := r.client.CreateRestore(ctx, &backup.CreateRestoreRequest{
+		ServiceID: data.ServiceID.ValueString(),
+		BackupID:  data.BackupID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error restoring backup",
+			fmt.Sprintf("Unable to restore backup %q into service %q: %s", data.BackupID.ValueString(), data.ServiceID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created backup restore resource", map[string]interface{}{
+		"id":         restore.ID,
+		"service_id": data.ServiceID.ValueString(),
+		"backup_id":  data.BackupID.ValueString(),
+	})
+
+	restoreToState(restore, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := waitForRestore(ctx, r.client, data.ServiceID.ValueString(), restore.ID, createTimeout)
+	if result != nil {
+		// Record the outcome even when the restore failed, for audit.
+		restoreToState(result, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error restoring backup",
+			fmt.Sprintf("Restore of backup %q into service %q did not complete: %s", data.BackupID.ValueString(), data.ServiceID.ValueString(), err))
+	}
+}
+
+func (r *BackupRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore, err := r.client.GetRestoreByID(ctx, data.ID.ValueString())
+	if err != nil {
+		// The restore already happened. Its record expiring from the API must
+		// not remove the resource, as that would run the restore again.
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Debug(ctx, "SkySQL restore record not found, keeping the recorded outcome", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error reading backup restore", err.Error())
+		return
+	}
+
+	restoreToState(restore, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BackupRestoreResourceModel
+	var state BackupRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute that affects the restore forces replacement,
+	// so only the timeouts can change here.
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BackupRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A restore cannot be undone; removing the resource only drops it from state.
+	tflog.Trace(ctx, "deleted backup restore resource")
+}
+
+func restoreToState(restore *backup.Restore, data *BackupRestoreResourceModel) {
+	data.ID = types.StringValue(restore.ID)
+	data.Status = types.StringValue(restore.Status)
+	if restore.Error != "" {
+		data.Error = types.StringValue(restore.Error)
+	} else {
+		data.Error = types.StringNull()
+	}
+	data.StartedAt = timeToString(restore.StartTime)
+	data.CompletedAt = timeToString(restore.EndTime)
+}
+
+// waitForRestore polls a restore until it completes and then waits for the
+// service to be ready again. Restore phases reported on the service itself,
+// such as pending_restore or restoring, are treated as pending.
+func waitForRestore(ctx context.Context, client *skysql.Client, serviceID string, restoreID string, timeout time.Duration) (*backup.Restore, error) {
+	var restore *backup.Restore
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		if restore == nil || restore.Status != backup.StatusSucceeded {
+			r, err := client.GetRestoreByID(ctx, restoreID)
+			if err != nil {
+				return sdkresource.NonRetryableError(fmt.Errorf("error retrieving restore details: %v", err))
+			}
+			restore = r
+
+			switch restore.Status {
+			case backup.StatusSucceeded:
+			case backup.StatusFailed:
+				if restore.Error != "" {
+					return sdkresource.NonRetryableError(fmt.Errorf("restore %s failed: %s", restoreID, restore.Error))
+				}
+				return sdkresource.NonRetryableError(fmt.Errorf("restore %s failed", restoreID))
+			default:
+				return sdkresource.RetryableError(fmt.Errorf("expected restore to be in succeeded or failed state but was in state %s", restore.Status))
+			}
+		}
+
+		service, err := client.GetServiceByID(ctx, serviceID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		switch service.Status {
+		case "ready":
+			return nil
+		case "failed":
+			return sdkresource.NonRetryableError(errors.New("service failed after restore"))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected instance to be ready after restore but was in state %s", service.Status))
+	})
+
+	return restore, err
+}
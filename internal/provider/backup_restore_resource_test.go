@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+const testBackupRestoreServiceID = "dbdgf42002450"
+
+func backupRestoreConfig(migration string) string {
+	return `
+	resource "skysql_backup_restore" "rollback" {
+		service_id = "` + testBackupRestoreServiceID + `"
+		backup_id  = "` + testBackupID + `"
+		triggers = {
+			migration = "` + migration + `"
+		}
+	}`
+}
+
+func createRestoreResponse(t *testing.T, restoreID string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/restores", req.URL.Path)
+
+		var payload backup.CreateRestoreRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(testBackupRestoreServiceID, payload.ServiceID)
+		r.Equal(testBackupID, payload.BackupID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(&backup.Restore{
+			ID:        restoreID,
+			ServiceID: testBackupRestoreServiceID,
+			BackupID:  testBackupID,
+			Status:    backup.StatusScheduled,
+		})
+	}
+}
+
+func getRestoreResponse(t *testing.T, restoreID string, status string, restoreErr string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/restores/"+restoreID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+		restore := &backup.Restore{
+			ID:        restoreID,
+			ServiceID: testBackupRestoreServiceID,
+			BackupID:  testBackupID,
+			Status:    status,
+			StartTime: &start,
+			Error:     restoreErr,
+		}
+		if status == backup.StatusSucceeded || status == backup.StatusFailed {
+			end := start.Add(15 * time.Minute)
+			restore.EndTime = &end
+		}
+		json.NewEncoder(w).Encode(restore)
+	}
+}
+
+func getBackupRestoreServiceResponse(t *testing.T, status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+testBackupRestoreServiceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		service := restoreTestService(testBackupRestoreServiceID)
+		service.Status = status
+		json.NewEncoder(w).Encode(service)
+	}
+}
+
+func TestBackupRestoreResource_TriggersRerun(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	// Step 1: restore, wait for the restore and for the service to be ready again
+	expectRequest(createRestoreResponse(t, "rst-100"))
+	expectRequest(getRestoreResponse(t, "rst-100", backup.StatusInProgress, ""))
+	expectRequest(getRestoreResponse(t, "rst-100", backup.StatusSucceeded, ""))
+	expectRequest(getBackupRestoreServiceResponse(t, "pending_restore"))
+	expectRequest(getBackupRestoreServiceResponse(t, "ready"))
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getRestoreResponse(t, "rst-100", backup.StatusSucceeded, ""))
+	expectRequest(getRestoreResponse(t, "rst-100", backup.StatusSucceeded, ""))
+
+	// Step 2: changed triggers run the restore again
+	expectRequest(createRestoreResponse(t, "rst-101"))
+	expectRequest(getRestoreResponse(t, "rst-101", backup.StatusSucceeded, ""))
+	expectRequest(getBackupRestoreServiceResponse(t, "ready"))
+	// Refresh after apply, then destroy which makes no API calls
+	expectRequest(getRestoreResponse(t, "rst-101", backup.StatusSucceeded, ""))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: backupRestoreConfig("0042"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "id", "rst-100"),
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "status", backup.StatusSucceeded),
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "started_at", "2026-03-01T10:00:00Z"),
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "completed_at", "2026-03-01T10:15:00Z"),
+					resource.TestCheckNoResourceAttr("skysql_backup_restore.rollback", "error"),
+				),
+			},
+			{
+				Config: backupRestoreConfig("0043"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "id", "rst-101"),
+					resource.TestCheckResourceAttr("skysql_backup_restore.rollback", "status", backup.StatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupRestoreResource_Failed(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(createRestoreResponse(t, "rst-200"))
+	expectRequest(getRestoreResponse(t, "rst-200", backup.StatusFailed, "backup is corrupted"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      backupRestoreConfig("0042"),
+				ExpectError: regexp.MustCompile(`backup is corrupted`),
+			},
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BackupsDataSource{}
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &BackupsDataSource{}
+}
+
+// BackupsDataSource defines the data source implementation.
+type BackupsDataSource struct {
+	client *skysql.Client
+}
+
+// BackupsDataSourceModel describes the data source data model.
+type BackupsDataSourceModel struct {
+	ServiceID     types.String  `tfsdk:"service_id"`
+	Status        types.String  `tfsdk:"status"`
+	BackupType    types.String  `tfsdk:"backup_type"`
+	StartedAfter  types.String  `tfsdk:"started_after"`
+	StartedBefore types.String  `tfsdk:"started_before"`
+	Backups       []BackupModel `tfsdk:"backups"`
+}
+
+type BackupModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ServiceID   types.String `tfsdk:"service_id"`
+	BackupType  types.String `tfsdk:"backup_type"`
+	Method      types.String `tfsdk:"method"`
+	Status      types.String `tfsdk:"status"`
+	SizeBytes   types.Int64  `tfsdk:"size_bytes"`
+	StartedAt   types.String `tfsdk:"started_at"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+}
+
+func (d *BackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+func (d *BackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the list of backups of a service.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter backups by status. Possible values are: scheduled, in_progress, succeeded or failed",
+				Validators: []validator.String{
+					stringvalidator.OneOf(backup.StatusScheduled, backup.StatusInProgress, backup.StatusSucceeded, backup.StatusFailed),
+				},
+			},
+			"backup_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter backups by type. Possible values are: full, incremental or snapshot",
+				Validators: []validator.String{
+					stringvalidator.OneOf(backup.TypeFull, backup.TypeIncremental, backup.TypeSnapshot),
+				},
+			},
+			"started_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return backups started at or after this time, in RFC 3339 format",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"started_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return backups started before this time, in RFC 3339 format",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the backup",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the backup",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the backed up service",
+						},
+						"backup_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the backup",
+						},
+						"method": schema.StringAttribute{
+							Computed:    true,
+							Description: "The method used to take the backup",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the backup",
+						},
+						"size_bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the backup in bytes",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the backup started, in RFC 3339 format",
+						},
+						"completed_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time the backup completed, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BackupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startedAfter := timeFilter(state.StartedAfter)
+	startedBefore := timeFilter(state.StartedBefore)
+
+	backups, err := d.client.GetBackups(ctx, state.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read SkySQL backups", err.Error())
+		return
+	}
+
+	state.Backups = make([]BackupModel, 0, len(backups))
+	for _, b := range backups {
+		if !state.Status.IsNull() && b.Status != state.Status.ValueString() {
+			continue
+		}
+		if !state.BackupType.IsNull() && b.Type != state.BackupType.ValueString() {
+			continue
+		}
+		if !backupStartedWithin(b, startedAfter, startedBefore) {
+			continue
+		}
+
+		state.Backups = append(state.Backups, BackupModel{
+			ID:          types.StringValue(b.ID),
+			Name:        types.StringValue(b.Name),
+			ServiceID:   types.StringValue(b.ServiceID),
+			BackupType:  types.StringValue(b.Type),
+			Method:      types.StringValue(b.Method),
+			Status:      types.StringValue(b.Status),
+			SizeBytes:   types.Int64Value(b.Size),
+			StartedAt:   timeToString(b.StartTime),
+			CompletedAt: timeToString(b.EndTime),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// timeFilter returns the time of an optional filter. The format is checked by rfc3339Validator.
+func timeFilter(value types.String) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	t, _ := time.Parse(time.RFC3339, value.ValueString())
+	return &t
+}
+
+// backupStartedWithin reports whether the backup started inside the optional [after, before) window.
+// Backups without a start time only match when no window is set.
+func backupStartedWithin(b backup.Backup, after *time.Time, before *time.Time) bool {
+	if after == nil && before == nil {
+		return true
+	}
+	if b.StartTime == nil {
+		return false
+	}
+	if after != nil && b.StartTime.Before(*after) {
+		return false
+	}
+	if before != nil && !b.StartTime.Before(*before) {
+		return false
+	}
+	return true
+}
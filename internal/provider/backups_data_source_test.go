@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBackupsAPI serves the backup list of testBackupServiceID. Terraform reads data sources on every
+// refresh and plan, so the list may be requested any number of times.
+func mockBackupsAPI(t *testing.T, backups []backup.Backup) (string, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/provisioning/v1/versions":
+			json.NewEncoder(w).Encode([]provisioning.Version{})
+		case "/skybackup/v1/backups":
+			r.Equal(testBackupServiceID, req.URL.Query().Get("service_id"))
+			json.NewEncoder(w).Encode(backup.ListBackupsResponse{Backups: backups})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			r.Failf("unexpected call", "%s %s", req.Method, req.URL.String())
+		}
+	}))
+	return ts.URL, ts.Close
+}
+
+func TestBackupsDataSource_Filters(t *testing.T) {
+	configureOnce.Reset()
+
+	at := func(value string) *time.Time {
+		parsed, _ := time.Parse(time.RFC3339, value)
+		return &parsed
+	}
+
+	testUrl, close := mockBackupsAPI(t, []backup.Backup{
+		{ID: "bkp-1", ServiceID: testBackupServiceID, Type: backup.TypeFull, Status: backup.StatusSucceeded, StartTime: at("2024-01-01T00:00:00Z")},
+		{ID: "bkp-2", ServiceID: testBackupServiceID, Type: backup.TypeIncremental, Status: backup.StatusSucceeded, StartTime: at("2024-01-15T00:00:00Z")},
+		{ID: "bkp-3", ServiceID: testBackupServiceID, Type: backup.TypeFull, Status: backup.StatusFailed, StartTime: at("2024-02-01T00:00:00Z")},
+		{ID: "bkp-4", ServiceID: testBackupServiceID, Type: backup.TypeSnapshot, Status: backup.StatusScheduled},
+	})
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "skysql_backups" "all" {
+					service_id = "%[1]s"
+				}
+
+				data "skysql_backups" "succeeded" {
+					service_id = "%[1]s"
+					status     = "succeeded"
+				}
+
+				data "skysql_backups" "full" {
+					service_id  = "%[1]s"
+					backup_type = "full"
+				}
+
+				data "skysql_backups" "january" {
+					service_id     = "%[1]s"
+					started_after  = "2024-01-01T00:00:00Z"
+					started_before = "2024-02-01T00:00:00Z"
+				}`, testBackupServiceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.skysql_backups.all", "backups.#", "4"),
+					resource.TestCheckResourceAttr("data.skysql_backups.succeeded", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.skysql_backups.succeeded", "backups.0.id", "bkp-1"),
+					resource.TestCheckResourceAttr("data.skysql_backups.succeeded", "backups.1.id", "bkp-2"),
+					resource.TestCheckResourceAttr("data.skysql_backups.full", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.skysql_backups.full", "backups.0.id", "bkp-1"),
+					resource.TestCheckResourceAttr("data.skysql_backups.full", "backups.1.id", "bkp-3"),
+					// started_after is inclusive and started_before is exclusive
+					resource.TestCheckResourceAttr("data.skysql_backups.january", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.skysql_backups.january", "backups.0.id", "bkp-1"),
+					resource.TestCheckResourceAttr("data.skysql_backups.january", "backups.1.id", "bkp-2"),
+					resource.TestCheckResourceAttr("data.skysql_backups.january", "backups.1.started_at", "2024-01-15T00:00:00Z"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				data "skysql_backups" "invalid" {
+					service_id    = "%s"
+					started_after = "2024-01-01"
+				}`, testBackupServiceID),
+				ExpectError: regexp.MustCompile(`Invalid time format`),
+			},
+		},
+	})
+}
+
+func TestBackupStartedWithin(t *testing.T) {
+	r := require.New(t)
+
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	startedAt := func(start time.Time) backup.Backup {
+		return backup.Backup{StartTime: &start}
+	}
+
+	// The window is [after, before)
+	r.True(backupStartedWithin(startedAt(after), &after, &before))
+	r.True(backupStartedWithin(startedAt(before.Add(-time.Second)), &after, &before))
+	r.False(backupStartedWithin(startedAt(before), &after, &before))
+	r.False(backupStartedWithin(startedAt(after.Add(-time.Second)), &after, &before))
+
+	// Each bound can be used alone
+	r.True(backupStartedWithin(startedAt(after), &after, nil))
+	r.False(backupStartedWithin(startedAt(after.Add(-time.Second)), &after, nil))
+	r.True(backupStartedWithin(startedAt(after.Add(-time.Second)), nil, &before))
+	r.False(backupStartedWithin(startedAt(before), nil, &before))
+
+	// A backup that has not started yet only matches without a window
+	r.True(backupStartedWithin(backup.Backup{}, nil, nil))
+	r.False(backupStartedWithin(backup.Backup{}, &after, nil))
+	r.False(backupStartedWithin(backup.Backup{}, nil, &before))
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &CreateBackupAction{}
+var _ action.ActionWithConfigure = &CreateBackupAction{}
+
+func NewCreateBackupAction() action.Action {
+	return &CreateBackupAction{}
+}
+
+// CreateBackupAction defines the action implementation.
+type CreateBackupAction struct {
+	client *skysql.Client
+}
+
+// CreateBackupActionModel describes the action data model.
+type CreateBackupActionModel struct {
+	ServiceID         types.String `tfsdk:"service_id"`
+	Name              types.String `tfsdk:"name"`
+	BackupType        types.String `tfsdk:"backup_type"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (a *CreateBackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_create_backup"
+}
+
+func (a *CreateBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers an on-demand backup of a service, for example before an upgrade. " +
+			"Unlike the skysql_backup resource, the backup is not tracked in state and is kept according to the retention of the service.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to back up",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "A name for the backup",
+			},
+			"backup_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the backup. Valid values are: full, incremental or snapshot. Default is full",
+				Validators: []validator.String{
+					stringvalidator.OneOf(backup.TypeFull, backup.TypeIncremental, backup.TypeSnapshot),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait for the backup to complete. Valid values are: true or false. Default is true",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the backup to complete, as a duration such as 30m. Default is 60m",
+			},
+		},
+	}
+}
+
+func (a *CreateBackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *CreateBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CreateBackupActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := actionTimeout(data.Timeout, defaultBackupTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid configuration", err.Error())
+		return
+	}
+
+	backupType := backup.TypeFull
+	if !data.BackupType.IsNull() {
+		backupType = data.BackupType.ValueString()
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	b, err := a.client.CreateBackup(ctx, &backup.CreateBackupRequest{
+		ServiceID: serviceID,
+		Type:      backupType,
+		Name:      data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating backup",
+			fmt.Sprintf("Unable to back up service %q: %s", serviceID, err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Started %s backup %s of service %s", backupType, b.ID, serviceID),
+	})
+
+	if data.WaitForCompletion.IsNull() || data.WaitForCompletion.ValueBool() {
+		b, err = waitForBackup(ctx, a.client, b.ID, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating backup", fmt.Sprintf("Backup did not complete: %s", err))
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Backup %s of service %s %s", b.ID, serviceID, b.Status),
+		})
+	}
+
+	tflog.Trace(ctx, "invoked create backup action", map[string]interface{}{
+		"id":         b.ID,
+		"service_id": serviceID,
+	})
+}
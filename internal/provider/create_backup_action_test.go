@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBackupAction(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002640"
+	const backupID = "bkp-42002640"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	b := &backup.Backup{
+		ID:        backupID,
+		ServiceID: serviceID,
+		Type:      backup.TypeFull,
+		Status:    backup.StatusInProgress,
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// A full backup is started and waited for
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/backups", req.URL.Path)
+
+		var payload backup.CreateBackupRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(serviceID, payload.ServiceID)
+		r.Equal(backup.TypeFull, payload.Type)
+		r.Equal("before-upgrade", payload.Name)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(b)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/backups/"+backupID, req.URL.Path)
+
+		b.Status = backup.StatusSucceeded
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(b)
+	})
+
+	progress, diags := invokeTestAction(t, "skysql_create_backup", map[string]tftypes.Value{
+		"service_id": tftypes.NewValue(tftypes.String, serviceID),
+		"name":       tftypes.NewValue(tftypes.String, "before-upgrade"),
+	})
+	require.Empty(t, diags)
+	require.Equal(t, []string{
+		"Started full backup " + backupID + " of service " + serviceID,
+		"Backup " + backupID + " of service " + serviceID + " succeeded",
+	}, progress)
+
+	// The backup type is validated before the action is invoked
+	_, diags = invokeTestAction(t, "skysql_create_backup", map[string]tftypes.Value{
+		"service_id":  tftypes.NewValue(tftypes.String, serviceID),
+		"backup_type": tftypes.NewValue(tftypes.String, "differential"),
+	})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, "value must be one of")
+}
@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExternalReplicationResource{}
+var _ resource.ResourceWithConfigure = &ExternalReplicationResource{}
+var _ resource.ResourceWithImportState = &ExternalReplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ExternalReplicationResource{}
+
+func NewExternalReplicationResource() resource.Resource {
+	return &ExternalReplicationResource{}
+}
+
+// ExternalReplicationResource defines the resource implementation.
+type ExternalReplicationResource struct {
+	client *skysql.Client
+}
+
+// ExternalReplicationResourceModel describes the resource data model.
+type ExternalReplicationResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	ServiceID            types.String   `tfsdk:"service_id"`
+	Host                 types.String   `tfsdk:"host"`
+	Port                 types.Int64    `tfsdk:"port"`
+	Username             types.String   `tfsdk:"username"`
+	Password             types.String   `tfsdk:"password"`
+	GTIDPosition         types.String   `tfsdk:"gtid_position"`
+	SSLEnabled           types.Bool     `tfsdk:"ssl_enabled"`
+	SSLCACertificate     types.String   `tfsdk:"ssl_ca_certificate"`
+	SSLClientCertificate types.String   `tfsdk:"ssl_client_certificate"`
+	SSLClientKey         types.String   `tfsdk:"ssl_client_key"`
+	SSLVerifyServerCert  types.Bool     `tfsdk:"ssl_verify_server_certificate"`
+	Enabled              types.Bool     `tfsdk:"enabled"`
+	ReplicationStatus    types.String   `tfsdk:"replication_status"`
+	SecondsBehindMaster  types.Int64    `tfsdk:"seconds_behind_master"`
+	LastError            types.String   `tfsdk:"last_error"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ExternalReplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_replication"
+}
+
+func (r *ExternalReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configures inbound replication into a service from a MariaDB or MySQL server running outside of SkySQL. " +
+			"Setting enabled starts or stops the replication in place. " +
+			"Destroying the resource stops the replication and resets its configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the external replication. Same as service_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service that replicates from the external server",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname or IP address of the external primary server",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3306),
+				Description: "The port of the external primary server. Default is 3306",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The replication user on the external primary server",
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Description: "The password of the replication user. Write-only: it is never returned by the API, so changes made outside of Terraform are not detected. " +
+					"After an import the password is taken from the configuration without configuring the replication again",
+			},
+			"gtid_position": schema.StringAttribute{
+				Optional: true,
+				Description: "The GTID position to start replicating from, for example 0-1-100. " +
+					"When omitted, replication starts from the current position of the external server. " +
+					"The position advances as replication runs, so it is only sent when the replication is configured",
+			},
+			"ssl_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to connect to the external primary server over SSL. Default is false",
+			},
+			"ssl_ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded CA certificate used to verify the external primary server. Requires ssl_enabled",
+			},
+			"ssl_client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded client certificate presented to the external primary server. Requires ssl_enabled",
+			},
+			"ssl_client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the client certificate. Requires ssl_enabled",
+			},
+			"ssl_verify_server_certificate": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to verify the certificate of the external primary server. Requires ssl_enabled. Default is false",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the replication is running. Set to false to stop it without losing its configuration. Default is true",
+			},
+			"replication_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the replication. Possible values are: running, stopped or error",
+			},
+			"seconds_behind_master": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How many seconds the service lags behind the external primary server. Null when the replication is not running",
+			},
+			"last_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last error reported by the replication, if any",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ExternalReplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ExternalReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExternalReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+
+	_, err := r.client.ConfigureExternalReplication(ctx, serviceID, data.request())
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring external replication",
+			fmt.Sprintf("Unable to configure external replication for service %q: %s", serviceID, err))
+		return
+	}
+
+	data.ID = types.StringValue(serviceID)
+
+	tflog.Trace(ctx, "created external replication resource", map[string]interface{}{
+		"id": serviceID,
+	})
+
+	if data.Enabled.ValueBool() && !r.start(ctx, serviceID, createTimeout, resp.Diagnostics.AddError) {
+		data.Enabled = types.BoolValue(false)
+	}
+
+	replication, err := r.client.GetExternalReplication(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading external replication", err.Error())
+		return
+	}
+	externalReplicationToState(replication, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExternalReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	replication, err := r.client.GetExternalReplication(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL external replication not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading external replication", err.Error())
+		return
+	}
+
+	data.ServiceID = data.ID
+	data.Host = types.StringValue(replication.Host)
+	data.Port = types.Int64Value(int64(replication.Port))
+	data.Username = types.StringValue(replication.Username)
+	data.SSLEnabled = types.BoolValue(replication.SSLEnabled)
+	data.SSLVerifyServerCert = types.BoolValue(replication.SSLVerifyServerCert)
+	// A replication that stopped on an error is still meant to run, so only an explicit stop counts as disabled.
+	data.Enabled = types.BoolValue(replication.Status != provisioning.ExternalReplicationStatusStopped)
+	externalReplicationToState(replication, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExternalReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExternalReplicationResourceModel
+	var state ExternalReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := state.ID.ValueString()
+	running := state.Enabled.ValueBool()
+
+	if plan.connectionChanged(&state) {
+		// The replication has to be stopped before its connection settings can change.
+		if running {
+			if err := r.stop(ctx, serviceID, updateTimeout); err != nil {
+				resp.Diagnostics.AddError("Error stopping external replication", err.Error())
+				return
+			}
+			running = false
+		}
+
+		_, err := r.client.ConfigureExternalReplication(ctx, serviceID, plan.request())
+		if err != nil {
+			resp.Diagnostics.AddError("Error configuring external replication",
+				fmt.Sprintf("Unable to configure external replication for service %q: %s", serviceID, err))
+			return
+		}
+	}
+
+	// enabled keeps the current value when the replication could not be started or stopped.
+	switch {
+	case plan.Enabled.ValueBool() && !running:
+		if !r.start(ctx, serviceID, updateTimeout, resp.Diagnostics.AddError) {
+			plan.Enabled = types.BoolValue(running)
+		}
+	case !plan.Enabled.ValueBool() && running:
+		if err := r.stop(ctx, serviceID, updateTimeout); err != nil {
+			resp.Diagnostics.AddError("Error stopping external replication", err.Error())
+			plan.Enabled = types.BoolValue(running)
+		}
+	}
+
+	replication, err := r.client.GetExternalReplication(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading external replication", err.Error())
+		return
+	}
+	plan.ID = state.ID
+	externalReplicationToState(replication, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ExternalReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExternalReplicationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ResetExternalReplication(ctx, state.ID.ValueString())
+	if err != nil && !errors.Is(err, skysql.ErrorServiceNotFound) {
+		resp.Diagnostics.AddError("Error resetting external replication",
+			fmt.Sprintf("Unable to reset external replication for service %q: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted external replication resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *ExternalReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+func (r *ExternalReplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ExternalReplicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SSLEnabled.IsUnknown() || plan.SSLEnabled.ValueBool() {
+		return
+	}
+
+	sslAttributes := map[string]bool{
+		"ssl_ca_certificate":            !plan.SSLCACertificate.IsNull(),
+		"ssl_client_certificate":        !plan.SSLClientCertificate.IsNull(),
+		"ssl_client_key":                !plan.SSLClientKey.IsNull(),
+		"ssl_verify_server_certificate": plan.SSLVerifyServerCert.ValueBool(),
+	}
+	for _, name := range []string{"ssl_ca_certificate", "ssl_client_certificate", "ssl_client_key", "ssl_verify_server_certificate"} {
+		if sslAttributes[name] {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid configuration",
+				fmt.Sprintf("%s requires ssl_enabled to be true", name))
+		}
+	}
+}
+
+// start starts the replication and waits for it to run. Failures are reported
+// through addError so the caller can still record the replication status.
+// It reports whether the start request was accepted, even when the replication then failed to run.
+func (r *ExternalReplicationResource) start(ctx context.Context, serviceID string, timeout time.Duration, addError func(string, string)) bool {
+	if err := r.client.StartExternalReplication(ctx, serviceID); err != nil {
+		addError("Error starting external replication",
+			fmt.Sprintf("Unable to start external replication for service %q: %s", serviceID, err))
+		return false
+	}
+
+	if err := waitForExternalReplication(ctx, r.client, serviceID, provisioning.ExternalReplicationStatusRunning, timeout); err != nil {
+		addError("Error starting external replication",
+			fmt.Sprintf("External replication for service %q did not start: %s", serviceID, err))
+	}
+	return true
+}
+
+func (r *ExternalReplicationResource) stop(ctx context.Context, serviceID string, timeout time.Duration) error {
+	if err := r.client.StopExternalReplication(ctx, serviceID); err != nil {
+		return fmt.Errorf("unable to stop external replication for service %q: %w", serviceID, err)
+	}
+
+	return waitForExternalReplication(ctx, r.client, serviceID, provisioning.ExternalReplicationStatusStopped, timeout)
+}
+
+func (m *ExternalReplicationResourceModel) request() *provisioning.ExternalReplicationRequest {
+	return &provisioning.ExternalReplicationRequest{
+		Host:                m.Host.ValueString(),
+		Port:                int(m.Port.ValueInt64()),
+		Username:            m.Username.ValueString(),
+		Password:            m.Password.ValueString(),
+		GTIDPosition:        m.GTIDPosition.ValueString(),
+		SSLEnabled:          m.SSLEnabled.ValueBool(),
+		SSLCACertificate:    m.SSLCACertificate.ValueString(),
+		SSLCertificate:      m.SSLClientCertificate.ValueString(),
+		SSLKey:              m.SSLClientKey.ValueString(),
+		SSLVerifyServerCert: m.SSLVerifyServerCert.ValueBool(),
+	}
+}
+
+// connectionChanged reports whether any setting sent to the configure call differs from the state.
+// The password and the other settings the API does not return are null after an import, so only
+// the settings read from the API are compared until the replication is configured by Terraform.
+func (m *ExternalReplicationResourceModel) connectionChanged(state *ExternalReplicationResourceModel) bool {
+	if state.Password.IsNull() {
+		return !m.Host.Equal(state.Host) ||
+			!m.Port.Equal(state.Port) ||
+			!m.Username.Equal(state.Username) ||
+			!m.SSLEnabled.Equal(state.SSLEnabled) ||
+			!m.SSLVerifyServerCert.Equal(state.SSLVerifyServerCert)
+	}
+	return !m.Host.Equal(state.Host) ||
+		!m.Port.Equal(state.Port) ||
+		!m.Username.Equal(state.Username) ||
+		!m.Password.Equal(state.Password) ||
+		!m.GTIDPosition.Equal(state.GTIDPosition) ||
+		!m.SSLEnabled.Equal(state.SSLEnabled) ||
+		!m.SSLCACertificate.Equal(state.SSLCACertificate) ||
+		!m.SSLClientCertificate.Equal(state.SSLClientCertificate) ||
+		!m.SSLClientKey.Equal(state.SSLClientKey) ||
+		!m.SSLVerifyServerCert.Equal(state.SSLVerifyServerCert)
+}
+
+func externalReplicationToState(replication *provisioning.ExternalReplication, data *ExternalReplicationResourceModel) {
+	data.ReplicationStatus = types.StringValue(replication.Status)
+	if replication.SecondsBehindMaster != nil {
+		data.SecondsBehindMaster = types.Int64Value(*replication.SecondsBehindMaster)
+	} else {
+		data.SecondsBehindMaster = types.Int64Null()
+	}
+	if replication.LastError != "" {
+		data.LastError = types.StringValue(replication.LastError)
+	} else {
+		data.LastError = types.StringNull()
+	}
+}
+
+func waitForExternalReplication(ctx context.Context, client *skysql.Client, serviceID string, status string, timeout time.Duration) error {
+	return sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		replication, err := client.GetExternalReplication(ctx, serviceID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving external replication details: %v", err))
+		}
+
+		switch replication.Status {
+		case status:
+			return nil
+		case provisioning.ExternalReplicationStatusError:
+			return sdkresource.NonRetryableError(fmt.Errorf("replication failed: %s", replication.LastError))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected replication to be %s but was %s", status, replication.Status))
+	})
+}
@@ -0,0 +1,982 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+const (
+	globalClusterRolePrimary = "primary"
+	globalClusterRoleReplica = "replica"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GlobalClusterResource{}
+var _ resource.ResourceWithConfigure = &GlobalClusterResource{}
+var _ resource.ResourceWithModifyPlan = &GlobalClusterResource{}
+
+func NewGlobalClusterResource() resource.Resource {
+	return &GlobalClusterResource{}
+}
+
+// GlobalClusterResource defines the resource implementation.
+type GlobalClusterResource struct {
+	client *skysql.Client
+}
+
+// GlobalClusterResourceModel describes the resource data model.
+type GlobalClusterResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	ProjectID          types.String   `tfsdk:"project_id"`
+	ServiceType        types.String   `tfsdk:"service_type"`
+	Topology           types.String   `tfsdk:"topology"`
+	Version            types.String   `tfsdk:"version"`
+	Architecture       types.String   `tfsdk:"architecture"`
+	SSLEnabled         types.Bool     `tfsdk:"ssl_enabled"`
+	Primary            types.Object   `tfsdk:"primary"`
+	Replicas           types.List     `tfsdk:"replicas"`
+	OutboundIPs        types.List     `tfsdk:"outbound_ips"`
+	ReplicaServiceIDs  types.Map      `tfsdk:"replica_service_ids"`
+	Endpoints          types.List     `tfsdk:"endpoints"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GlobalClusterRegionModel is the spec of the primary or of one replica region.
+type GlobalClusterRegionModel struct {
+	Name          types.String `tfsdk:"name"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Region        types.String `tfsdk:"region"`
+	Size          types.String `tfsdk:"size"`
+	Nodes         types.Int64  `tfsdk:"nodes"`
+	Storage       types.Int64  `tfsdk:"storage"`
+}
+
+var globalClusterRegionAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"cloud_provider": types.StringType,
+	"region":         types.StringType,
+	"size":           types.StringType,
+	"nodes":          types.Int64Type,
+	"storage":        types.Int64Type,
+}
+
+// GlobalClusterEndpointModel describes how to reach one service of the cluster.
+type GlobalClusterEndpointModel struct {
+	Role      types.String `tfsdk:"role"`
+	Name      types.String `tfsdk:"name"`
+	Region    types.String `tfsdk:"region"`
+	ServiceID types.String `tfsdk:"service_id"`
+	FQDN      types.String `tfsdk:"fqdn"`
+}
+
+var globalClusterEndpointAttrTypes = map[string]attr.Type{
+	"role":       types.StringType,
+	"name":       types.StringType,
+	"region":     types.StringType,
+	"service_id": types.StringType,
+	"fqdn":       types.StringType,
+}
+
+func (r *GlobalClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_cluster"
+}
+
+func globalClusterRegionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the service",
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 24),
+				stringvalidator.RegexMatches(
+					rxServiceName,
+					"must start from a lowercase letter and contain only lowercase letters, numbers and hyphens",
+				),
+			},
+		},
+		"cloud_provider": schema.StringAttribute{
+			Required:    true,
+			Description: "The cloud provider to create the service in. Valid values are: aws or gcp",
+		},
+		"region": schema.StringAttribute{
+			Required:    true,
+			Description: "The region to create the service in. Value should be valid for a specific cloud provider",
+		},
+		"size": schema.StringAttribute{
+			Required:    true,
+			Description: "The size of the service. Valid values are: sky-2x4, sky-2x8 etc",
+		},
+		"nodes": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The number of nodes. Default is 1",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"storage": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The storage size in GB. Default is 100",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func (r *GlobalClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a primary service and its cross-region replicas as one unit. " +
+			"The primary is created first, then each replica in the order of replicas. " +
+			"The outbound IPs of the primary are added to the allow list of every replica. " +
+			"Replica regions can be added or removed in place; changing the spec of an existing replica region recreates that replica. " +
+			"Destroying the resource deletes the replicas and then the primary.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the global cluster. Same as the ID of the primary service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the project to create the services in",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("transactional"),
+				Description: "The type of the services. Valid values are: analytical or transactional. Default is transactional",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topology": schema.StringAttribute{
+				Required:    true,
+				Description: "The topology of every service of the cluster",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "The software version of every service of the cluster",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("amd64"),
+				Description: "The architecture of the services. Valid values are: amd64 or arm64. Default is amd64",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssl_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to enable SSL on the services. Default is true",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The primary service. Changing it recreates the whole cluster",
+				Attributes:  globalClusterRegionAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"replicas": schema.ListNestedAttribute{
+				Optional: true,
+				Description: "The replica services, one per region. Each region can appear only once. " +
+					"Changing the size, nodes or storage of a replica resizes it in place. " +
+					"Changing its name or cloud_provider deletes the replica and creates a new one, which requires deletion_protection = false",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: globalClusterRegionAttributes(),
+				},
+			},
+			"outbound_ips": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The outbound IP addresses of the primary service. They are added to the allow list of every replica",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replica_service_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the replica services, keyed by region",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The endpoints of every service of the cluster, starting with the primary",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role of the service. Possible values are: primary or replica",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the service",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region of the service",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the service",
+						},
+						"fqdn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The fully qualified domain name of the service",
+						},
+					},
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to prevent the cluster from being deleted. Removing a replica region is still allowed. Default is true",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *GlobalClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GlobalClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GlobalClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var primarySpec GlobalClusterRegionModel
+	resp.Diagnostics.Append(data.Primary.As(ctx, &primarySpec, basetypes.ObjectAsOptions{})...)
+	replicaSpecs, diags := data.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.CreateService(ctx, data.serviceRequest(primarySpec))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating global cluster",
+			fmt.Sprintf("Unable to create primary service %q: %s", primarySpec.Name.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created global cluster primary", map[string]interface{}{
+		"id": service.ID,
+	})
+
+	data.ID = types.StringValue(service.ID)
+	cluster := newGlobalClusterState(nil)
+	cluster.endpoints[service.ID] = globalClusterEndpoint(globalClusterRolePrimary, service)
+	resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primary, err := waitForServiceReady(ctx, r.client, service.ID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating global cluster",
+			fmt.Sprintf("Primary service %q did not become ready: %s", service.ID, err))
+		return
+	}
+	cluster.endpoints[primary.ID] = globalClusterEndpoint(globalClusterRolePrimary, primary)
+
+	data.OutboundIPs, diags = types.ListValueFrom(ctx, types.StringType, primary.OutboundIps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, spec := range replicaSpecs {
+		r.createReplica(ctx, &data, primary, spec, cluster, createTimeout, &resp.Diagnostics)
+		resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GlobalClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	primary, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL global cluster primary not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading global cluster", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.OutboundIPs, diags = types.ListValueFrom(ctx, types.StringType, primary.OutboundIps)
+	resp.Diagnostics.Append(diags...)
+
+	replicaSpecs, diags := data.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	replicaIDs, diags := data.replicaServiceIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster := newGlobalClusterState(nil)
+	cluster.endpoints[primary.ID] = globalClusterEndpoint(globalClusterRolePrimary, primary)
+	for _, spec := range replicaSpecs {
+		region := spec.Region.ValueString()
+		serviceID, ok := replicaIDs[region]
+		if !ok {
+			continue
+		}
+
+		replica, err := r.client.GetServiceByID(ctx, serviceID)
+		if err != nil {
+			// A replica deleted outside of Terraform is dropped, so the next plan adds it back.
+			if errors.Is(err, skysql.ErrorServiceNotFound) {
+				tflog.Warn(ctx, "SkySQL global cluster replica not found, removing from state", map[string]interface{}{
+					"id":     serviceID,
+					"region": region,
+				})
+				continue
+			}
+			resp.Diagnostics.AddError("Error reading global cluster", err.Error())
+			return
+		}
+
+		cluster.addReplica(spec, replica.ID)
+		cluster.endpoints[replica.ID] = globalClusterEndpoint(globalClusterRoleReplica, replica)
+	}
+
+	resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GlobalClusterResourceModel
+	var state GlobalClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute forces replacement, so only the replicas and the
+	// provider-side settings can change here.
+	data := state
+	data.Replicas = plan.Replicas
+	data.DeletionProtection = plan.DeletionProtection
+	data.Timeouts = plan.Timeouts
+
+	stateSpecs, diags := state.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	planSpecs, diags := plan.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	replicaIDs, diags := state.replicaServiceIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	var endpoints []GlobalClusterEndpointModel
+	resp.Diagnostics.Append(state.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster := newGlobalClusterState(endpoints)
+	for _, spec := range stateSpecs {
+		if serviceID, ok := replicaIDs[spec.Region.ValueString()]; ok {
+			cluster.addReplica(spec, serviceID)
+		}
+	}
+
+	planned := make(map[string]GlobalClusterRegionModel, len(planSpecs))
+	for _, spec := range planSpecs {
+		planned[spec.Region.ValueString()] = spec
+	}
+
+	// Remove the replicas that are no longer wanted, or whose name or cloud provider changed, first.
+	for _, spec := range stateSpecs {
+		region := spec.Region.ValueString()
+		serviceID, ok := cluster.ids[region]
+		if !ok {
+			continue
+		}
+		if want, ok := planned[region]; ok && !replicaRecreated(spec, want) {
+			continue
+		}
+
+		r.deleteReplica(ctx, region, serviceID, updateTimeout, &resp.Diagnostics)
+		if !resp.Diagnostics.HasError() {
+			cluster.removeReplica(region)
+		}
+		resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Resize the remaining replicas in place.
+	for _, spec := range planSpecs {
+		region := spec.Region.ValueString()
+		serviceID, ok := cluster.ids[region]
+		if !ok {
+			continue
+		}
+		current := cluster.spec(region)
+		if current == spec {
+			continue
+		}
+
+		r.resizeReplica(ctx, serviceID, current, spec, updateTimeout, &resp.Diagnostics)
+		if !resp.Diagnostics.HasError() {
+			cluster.updateReplica(spec)
+		}
+		resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var primary *provisioning.Service
+	for _, spec := range planSpecs {
+		if _, ok := cluster.ids[spec.Region.ValueString()]; ok {
+			continue
+		}
+
+		if primary == nil {
+			var err error
+			primary, err = r.client.GetServiceByID(ctx, state.ID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating global cluster", err.Error())
+				return
+			}
+			data.OutboundIPs, diags = types.ListValueFrom(ctx, types.StringType, primary.OutboundIps)
+			resp.Diagnostics.Append(diags...)
+		}
+
+		r.createReplica(ctx, &data, primary, spec, cluster, updateTimeout, &resp.Diagnostics)
+		resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Keep the replicas in the configured order.
+	cluster.order(planSpecs)
+	resp.Diagnostics.Append(cluster.apply(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlobalClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GlobalClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Can not delete global cluster", "Deletion protection is enabled")
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	replicaSpecs, diags := data.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	replicaIDs, diags := data.replicaServiceIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replicas go first, newest first, so the primary never loses a replica it still serves.
+	for i := len(replicaSpecs) - 1; i >= 0; i-- {
+		region := replicaSpecs[i].Region.ValueString()
+		if serviceID, ok := replicaIDs[region]; ok {
+			r.deleteReplica(ctx, region, serviceID, deleteTimeout, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	err := r.client.DeleteServiceByID(ctx, data.ID.ValueString())
+	if err != nil && !errors.Is(err, skysql.ErrorServiceNotFound) {
+		resp.Diagnostics.AddError("Error deleting global cluster",
+			fmt.Sprintf("Unable to delete primary service %q: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	if err := waitForServiceDeleted(ctx, r.client, data.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError("Error deleting global cluster",
+			fmt.Sprintf("Unable to delete primary service %q: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted global cluster resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *GlobalClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GlobalClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Replicas.IsUnknown() {
+		return
+	}
+
+	replicaSpecs, diags := plan.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(replicaSpecs))
+	for i, spec := range replicaSpecs {
+		if spec.Region.IsUnknown() {
+			continue
+		}
+		region := spec.Region.ValueString()
+		if seen[region] {
+			resp.Diagnostics.AddAttributeError(path.Root("replicas").AtListIndex(i).AtName("region"),
+				"Invalid configuration",
+				fmt.Sprintf("Region %q is used by more than one replica", region))
+		}
+		seen[region] = true
+	}
+
+	// The replica IDs and endpoints are kept from state unless the replicas change.
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state GlobalClusterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Replicas.Equal(state.Replicas) {
+		return
+	}
+
+	stateSpecs, diags := state.replicas(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, current := range stateSpecs {
+		for i, spec := range replicaSpecs {
+			if !spec.Region.Equal(current.Region) || spec.Name.IsUnknown() || spec.CloudProvider.IsUnknown() ||
+				!replicaRecreated(current, spec) {
+				continue
+			}
+			if plan.DeletionProtection.ValueBool() {
+				resp.Diagnostics.AddAttributeError(path.Root("replicas").AtListIndex(i),
+					"Invalid configuration",
+					fmt.Sprintf("Changing the name or cloud_provider of the replica in region %q deletes it and creates a new one, "+
+						"set deletion_protection = false first", current.Region.ValueString()))
+				continue
+			}
+			resp.Diagnostics.AddAttributeWarning(path.Root("replicas").AtListIndex(i),
+				"Replica will be recreated",
+				fmt.Sprintf("The replica in region %q is deleted and created again with its new name or cloud_provider", current.Region.ValueString()))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("replica_service_ids"), types.MapUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("endpoints"), types.ListUnknown(state.Endpoints.ElementType(ctx)))...)
+}
+
+// createReplica creates one replica of the primary and waits for it to be ready.
+// The replica is recorded in cluster as soon as the API accepts it, so a failed
+// wait still leaves it tracked in state.
+func (r *GlobalClusterResource) createReplica(
+	ctx context.Context,
+	data *GlobalClusterResourceModel,
+	primary *provisioning.Service,
+	spec GlobalClusterRegionModel,
+	cluster *globalClusterState,
+	timeout time.Duration,
+	diags *diag.Diagnostics,
+) {
+	request := data.serviceRequest(spec)
+	request.ReplicationEnabled = true
+	request.PrimaryHost = primary.ID
+	for _, ip := range primary.OutboundIps {
+		request.AllowList = append(request.AllowList, provisioning.AllowListItem{
+			IPAddress: ipToCIDR(ip),
+			Comment:   "global cluster primary " + primary.ID,
+		})
+	}
+
+	service, err := r.client.CreateService(ctx, request)
+	if err != nil {
+		diags.AddError("Error creating global cluster replica",
+			fmt.Sprintf("Unable to create replica %q in region %q: %s", spec.Name.ValueString(), spec.Region.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "created global cluster replica", map[string]interface{}{
+		"id":     service.ID,
+		"region": spec.Region.ValueString(),
+	})
+
+	cluster.addReplica(spec, service.ID)
+	cluster.endpoints[service.ID] = globalClusterEndpoint(globalClusterRoleReplica, service)
+
+	replica, err := waitForServiceReady(ctx, r.client, service.ID, timeout)
+	if err != nil {
+		diags.AddError("Error creating global cluster replica",
+			fmt.Sprintf("Replica %q in region %q did not become ready: %s", service.ID, spec.Region.ValueString(), err))
+		return
+	}
+	cluster.endpoints[replica.ID] = globalClusterEndpoint(globalClusterRoleReplica, replica)
+}
+
+// resizeReplica applies the size, node and storage changes of a replica through the service update calls.
+func (r *GlobalClusterResource) resizeReplica(
+	ctx context.Context,
+	serviceID string,
+	current GlobalClusterRegionModel,
+	spec GlobalClusterRegionModel,
+	timeout time.Duration,
+	diags *diag.Diagnostics,
+) {
+	region := spec.Region.ValueString()
+	changes := []struct {
+		changed bool
+		modify  func() error
+	}{
+		{
+			changed: !spec.Size.Equal(current.Size),
+			modify: func() error {
+				return r.client.ModifyServiceSize(ctx, serviceID, spec.Size.ValueString())
+			},
+		},
+		{
+			changed: replicaNodes(spec) != replicaNodes(current),
+			modify: func() error {
+				return r.client.ModifyServiceNodeNumber(ctx, serviceID, &provisioning.UpdateServiceNodesNumberRequest{Nodes: replicaNodes(spec)})
+			},
+		},
+		{
+			changed: replicaStorage(spec) != replicaStorage(current),
+			modify: func() error {
+				return r.client.ModifyServiceStorage(ctx, serviceID, replicaStorage(spec), 0, 0)
+			},
+		},
+	}
+
+	for _, change := range changes {
+		if !change.changed {
+			continue
+		}
+
+		tflog.Info(ctx, "Resizing global cluster replica", map[string]interface{}{
+			"id":     serviceID,
+			"region": region,
+		})
+		if err := change.modify(); err != nil {
+			diags.AddError("Error updating global cluster replica",
+				fmt.Sprintf("Unable to resize replica %q in region %q: %s", serviceID, region, err))
+			return
+		}
+		if _, err := waitForServiceReady(ctx, r.client, serviceID, timeout); err != nil {
+			diags.AddError("Error updating global cluster replica",
+				fmt.Sprintf("Replica %q in region %q did not become ready after the resize: %s", serviceID, region, err))
+			return
+		}
+	}
+}
+
+func (r *GlobalClusterResource) deleteReplica(ctx context.Context, region string, serviceID string, timeout time.Duration, diags *diag.Diagnostics) {
+	err := r.client.DeleteServiceByID(ctx, serviceID)
+	if err != nil && !errors.Is(err, skysql.ErrorServiceNotFound) {
+		diags.AddError("Error deleting global cluster replica",
+			fmt.Sprintf("Unable to delete replica %q in region %q: %s", serviceID, region, err))
+		return
+	}
+
+	if err := waitForServiceDeleted(ctx, r.client, serviceID, timeout); err != nil {
+		diags.AddError("Error deleting global cluster replica",
+			fmt.Sprintf("Unable to delete replica %q in region %q: %s", serviceID, region, err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted global cluster replica", map[string]interface{}{
+		"id":     serviceID,
+		"region": region,
+	})
+}
+
+func (m *GlobalClusterResourceModel) replicas(ctx context.Context) ([]GlobalClusterRegionModel, diag.Diagnostics) {
+	var specs []GlobalClusterRegionModel
+	if m.Replicas.IsNull() || m.Replicas.IsUnknown() {
+		return specs, nil
+	}
+	diags := m.Replicas.ElementsAs(ctx, &specs, false)
+	return specs, diags
+}
+
+func (m *GlobalClusterResourceModel) replicaServiceIDs(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	ids := make(map[string]string)
+	if m.ReplicaServiceIDs.IsNull() || m.ReplicaServiceIDs.IsUnknown() {
+		return ids, nil
+	}
+	diags := m.ReplicaServiceIDs.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// replicaRecreated reports whether changing a replica from current to spec requires a new service.
+// Size, nodes and storage are changed in place.
+func replicaRecreated(current GlobalClusterRegionModel, spec GlobalClusterRegionModel) bool {
+	return !spec.Name.Equal(current.Name) || !spec.CloudProvider.Equal(current.CloudProvider)
+}
+
+func replicaNodes(spec GlobalClusterRegionModel) int64 {
+	if spec.Nodes.IsNull() {
+		return 1
+	}
+	return spec.Nodes.ValueInt64()
+}
+
+func replicaStorage(spec GlobalClusterRegionModel) int64 {
+	if spec.Storage.IsNull() {
+		return 100
+	}
+	return spec.Storage.ValueInt64()
+}
+
+// serviceRequest builds the create request for one region of the cluster from the shared settings.
+func (m *GlobalClusterResourceModel) serviceRequest(spec GlobalClusterRegionModel) *provisioning.CreateServiceRequest {
+	nodes := uint(replicaNodes(spec))
+	storage := uint(replicaStorage(spec))
+
+	return &provisioning.CreateServiceRequest{
+		Name:         spec.Name.ValueString(),
+		ProjectID:    m.ProjectID.ValueString(),
+		ServiceType:  m.ServiceType.ValueString(),
+		Provider:     spec.CloudProvider.ValueString(),
+		Region:       spec.Region.ValueString(),
+		Version:      m.Version.ValueString(),
+		Nodes:        nodes,
+		Architecture: m.Architecture.ValueString(),
+		Size:         spec.Size.ValueString(),
+		Topology:     m.Topology.ValueString(),
+		Storage:      storage,
+		SSLEnabled:   m.SSLEnabled.ValueBool(),
+	}
+}
+
+// globalClusterState tracks the replicas that exist while the cluster is being
+// changed, so the state written after a partial failure matches the API.
+type globalClusterState struct {
+	specs     []GlobalClusterRegionModel
+	ids       map[string]string
+	endpoints map[string]GlobalClusterEndpointModel
+}
+
+func newGlobalClusterState(endpoints []GlobalClusterEndpointModel) *globalClusterState {
+	cluster := &globalClusterState{
+		ids:       make(map[string]string),
+		endpoints: make(map[string]GlobalClusterEndpointModel),
+	}
+	for _, endpoint := range endpoints {
+		cluster.endpoints[endpoint.ServiceID.ValueString()] = endpoint
+	}
+	return cluster
+}
+
+func (c *globalClusterState) addReplica(spec GlobalClusterRegionModel, serviceID string) {
+	c.specs = append(c.specs, spec)
+	c.ids[spec.Region.ValueString()] = serviceID
+}
+
+func (c *globalClusterState) spec(region string) GlobalClusterRegionModel {
+	for _, spec := range c.specs {
+		if spec.Region.ValueString() == region {
+			return spec
+		}
+	}
+	return GlobalClusterRegionModel{}
+}
+
+func (c *globalClusterState) updateReplica(spec GlobalClusterRegionModel) {
+	for i := range c.specs {
+		if c.specs[i].Region.ValueString() == spec.Region.ValueString() {
+			c.specs[i] = spec
+			return
+		}
+	}
+}
+
+func (c *globalClusterState) removeReplica(region string) {
+	delete(c.endpoints, c.ids[region])
+	delete(c.ids, region)
+	for i, spec := range c.specs {
+		if spec.Region.ValueString() == region {
+			c.specs = append(c.specs[:i], c.specs[i+1:]...)
+			return
+		}
+	}
+}
+
+// order sorts the tracked replicas in the order of specs.
+func (c *globalClusterState) order(specs []GlobalClusterRegionModel) {
+	ordered := make([]GlobalClusterRegionModel, 0, len(c.specs))
+	for _, spec := range specs {
+		if _, ok := c.ids[spec.Region.ValueString()]; ok {
+			ordered = append(ordered, spec)
+		}
+	}
+	c.specs = ordered
+}
+
+// apply writes the tracked replicas and endpoints into data.
+func (c *globalClusterState) apply(ctx context.Context, data *GlobalClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	endpoints := []GlobalClusterEndpointModel{}
+	if primary, ok := c.endpoints[data.ID.ValueString()]; ok {
+		endpoints = append(endpoints, primary)
+	}
+	for _, spec := range c.specs {
+		if endpoint, ok := c.endpoints[c.ids[spec.Region.ValueString()]]; ok {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	var d diag.Diagnostics
+	data.Endpoints, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: globalClusterEndpointAttrTypes}, endpoints)
+	diags.Append(d...)
+
+	// Keep replicas null when none are configured, so an omitted attribute does not show up as drift.
+	if len(c.specs) > 0 || !data.Replicas.IsNull() {
+		specs := append([]GlobalClusterRegionModel{}, c.specs...)
+		data.Replicas, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: globalClusterRegionAttrTypes}, specs)
+		diags.Append(d...)
+	}
+
+	data.ReplicaServiceIDs, d = types.MapValueFrom(ctx, types.StringType, c.ids)
+	diags.Append(d...)
+
+	if data.OutboundIPs.IsUnknown() {
+		data.OutboundIPs = types.ListNull(types.StringType)
+	}
+
+	return diags
+}
+
+func globalClusterEndpoint(role string, service *provisioning.Service) GlobalClusterEndpointModel {
+	return GlobalClusterEndpointModel{
+		Role:      types.StringValue(role),
+		Name:      types.StringValue(service.Name),
+		Region:    types.StringValue(service.Region),
+		ServiceID: types.StringValue(service.ID),
+		FQDN:      types.StringValue(service.FQDN),
+	}
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalClusterResource_AddAndRemoveReplicaRegions(t *testing.T) {
+	configureOnce.Reset()
+
+	const primaryID = "dbdgf42002500"
+	const euReplicaID = "dbdgf42002501"
+	const asiaReplicaID = "dbdgf42002502"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	services := map[string]*provisioning.Service{
+		primaryID:     {ID: primaryID, Name: "orders", Region: "us-central1", FQDN: "orders.skysql.com", OutboundIps: []string{"34.1.1.1"}},
+		euReplicaID:   {ID: euReplicaID, Name: "orders-eu", Region: "europe-west1", FQDN: "orders-eu.skysql.com"},
+		asiaReplicaID: {ID: asiaReplicaID, Name: "orders-asia", Region: "asia-east1", FQDN: "orders-asia.skysql.com"},
+	}
+	getService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			service := *services[serviceID]
+			service.Status = "ready"
+			json.NewEncoder(w).Encode(service)
+		}
+	}
+	serviceGone := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+		}
+	}
+	deleteService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodDelete, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}
+	createReplica := func(serviceID string, region string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPost, req.Method)
+			r.Equal("/provisioning/v1/services", req.URL.Path)
+
+			var payload provisioning.CreateServiceRequest
+			r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+			r.Equal(region, payload.Region)
+			r.True(payload.ReplicationEnabled)
+			r.Equal(primaryID, payload.PrimaryHost)
+			r.Equal("es-replica", payload.Topology)
+			r.Equal("10.6.11-6-1", payload.Version)
+			r.Equal([]provisioning.AllowListItem{{IPAddress: "34.1.1.1/32", Comment: "global cluster primary " + primaryID}}, payload.AllowList)
+
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(services[serviceID])
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("orders", payload.Name)
+		r.Equal("us-central1", payload.Region)
+		r.False(payload.ReplicationEnabled)
+		r.Empty(payload.AllowList)
+		r.EqualValues(1, payload.Nodes)
+		r.EqualValues(200, payload.Storage)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(services[primaryID])
+	})
+	expectRequest(getService(primaryID))
+	expectRequest(createReplica(euReplicaID, "europe-west1"))
+	expectRequest(getService(euReplicaID))
+	// Refresh after apply and before the next plan
+	expectRequest(getService(primaryID))
+	expectRequest(getService(euReplicaID))
+	expectRequest(getService(primaryID))
+	expectRequest(getService(euReplicaID))
+	// Remove the eu replica, then add the asia replica
+	expectRequest(deleteService(euReplicaID))
+	expectRequest(serviceGone(euReplicaID))
+	expectRequest(getService(primaryID))
+	expectRequest(createReplica(asiaReplicaID, "asia-east1"))
+	expectRequest(getService(asiaReplicaID))
+	expectRequest(getService(primaryID))
+	expectRequest(getService(asiaReplicaID))
+	// Destroy deletes the replicas before the primary
+	expectRequest(deleteService(asiaReplicaID))
+	expectRequest(serviceGone(asiaReplicaID))
+	expectRequest(deleteService(primaryID))
+	expectRequest(serviceGone(primaryID))
+
+	config := func(replicas string) string {
+		return `
+		resource "skysql_global_cluster" "orders" {
+			topology            = "es-replica"
+			version             = "10.6.11-6-1"
+			deletion_protection = false
+
+			primary = {
+				name           = "orders"
+				cloud_provider = "gcp"
+				region         = "us-central1"
+				size           = "sky-2x8"
+				storage        = 200
+			}
+
+			replicas = [` + replicas + `]
+		}`
+	}
+	replica := func(name string, region string) string {
+		return `{
+			name           = "` + name + `"
+			cloud_provider = "gcp"
+			region         = "` + region + `"
+			size           = "sky-2x8"
+		}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(replica("orders-eu", "europe-west1")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "id", primaryID),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "outbound_ips.0", "34.1.1.1"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replica_service_ids.europe-west1", euReplicaID),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.#", "2"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.0.role", "primary"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.0.fqdn", "orders.skysql.com"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.1.role", "replica"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.1.fqdn", "orders-eu.skysql.com"),
+				),
+			},
+			{
+				Config: config(replica("orders-asia", "asia-east1")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "id", primaryID),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replica_service_ids.%", "1"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replica_service_ids.asia-east1", asiaReplicaID),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.#", "2"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "endpoints.1.region", "asia-east1"),
+				),
+			},
+		},
+	})
+}
+
+func TestGlobalClusterResource_ResizeReplica(t *testing.T) {
+	configureOnce.Reset()
+
+	const primaryID = "dbdgf42002510"
+	const euReplicaID = "dbdgf42002511"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	services := map[string]*provisioning.Service{
+		primaryID:   {ID: primaryID, Name: "orders", Region: "us-central1", FQDN: "orders.skysql.com", OutboundIps: []string{"34.1.1.1"}},
+		euReplicaID: {ID: euReplicaID, Name: "orders-eu", Region: "europe-west1", FQDN: "orders-eu.skysql.com"},
+	}
+	getService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			service := *services[serviceID]
+			service.Status = "ready"
+			json.NewEncoder(w).Encode(service)
+		}
+	}
+	serviceGone := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+		}
+	}
+	deleteService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodDelete, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}
+	createService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPost, req.Method)
+			r.Equal("/provisioning/v1/services", req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(services[serviceID])
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(createService(primaryID))
+	expectRequest(getService(primaryID))
+	expectRequest(createService(euReplicaID))
+	expectRequest(getService(euReplicaID))
+	// Refresh after apply and before the next plan
+	expectRequest(getService(primaryID))
+	expectRequest(getService(euReplicaID))
+	expectRequest(getService(primaryID))
+	expectRequest(getService(euReplicaID))
+	// The size and storage of the replica change in place, without deleting it
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+euReplicaID+"/size", req.URL.Path)
+
+		var payload provisioning.UpdateServiceSizeRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("sky-4x16", payload.Size)
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService(euReplicaID))
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPatch, req.Method)
+		r.Equal("/provisioning/v1/services/"+euReplicaID+"/storage", req.URL.Path)
+
+		var payload provisioning.UpdateStorageRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.EqualValues(200, payload.Size)
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService(euReplicaID))
+	expectRequest(getService(primaryID))
+	expectRequest(getService(euReplicaID))
+	// Destroy deletes the replica before the primary
+	expectRequest(deleteService(euReplicaID))
+	expectRequest(serviceGone(euReplicaID))
+	expectRequest(deleteService(primaryID))
+	expectRequest(serviceGone(primaryID))
+
+	config := func(size string, storage int) string {
+		return fmt.Sprintf(`
+		resource "skysql_global_cluster" "orders" {
+			topology            = "es-replica"
+			version             = "10.6.11-6-1"
+			deletion_protection = false
+
+			primary = {
+				name           = "orders"
+				cloud_provider = "gcp"
+				region         = "us-central1"
+				size           = "sky-2x8"
+			}
+
+			replicas = [{
+				name           = "orders-eu"
+				cloud_provider = "gcp"
+				region         = "europe-west1"
+				size           = %q
+				storage        = %d
+			}]
+		}`, size, storage)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("sky-2x8", 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replica_service_ids.europe-west1", euReplicaID),
+				),
+			},
+			{
+				Config: config("sky-4x16", 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replica_service_ids.europe-west1", euReplicaID),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replicas.0.size", "sky-4x16"),
+					resource.TestCheckResourceAttr("skysql_global_cluster.orders", "replicas.0.storage", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestReplicaRecreated(t *testing.T) {
+	r := require.New(t)
+
+	current := GlobalClusterRegionModel{
+		Name:          types.StringValue("orders-eu"),
+		CloudProvider: types.StringValue("gcp"),
+		Region:        types.StringValue("europe-west1"),
+		Size:          types.StringValue("sky-2x8"),
+	}
+
+	resized := current
+	resized.Size = types.StringValue("sky-4x16")
+	resized.Storage = types.Int64Value(200)
+	r.False(replicaRecreated(current, resized))
+
+	renamed := current
+	renamed.Name = types.StringValue("orders-europe")
+	r.True(replicaRecreated(current, renamed))
+}
+
+func TestGlobalClusterResource_DuplicateReplicaRegion(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_global_cluster" "orders" {
+					topology = "es-replica"
+					version  = "10.6.11-6-1"
+
+					primary = {
+						name           = "orders"
+						cloud_provider = "gcp"
+						region         = "us-central1"
+						size           = "sky-2x8"
+					}
+
+					replicas = [
+						{
+							name           = "orders-eu"
+							cloud_provider = "gcp"
+							region         = "europe-west1"
+							size           = "sky-2x8"
+						},
+						{
+							name           = "orders-eu-2"
+							cloud_provider = "gcp"
+							region         = "europe-west1"
+							size           = "sky-2x8"
+						},
+					]
+				}`,
+				ExpectError: regexp.MustCompile(`Region "europe-west1" is used by more than one replica`),
+			},
+		},
+	})
+}
@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// Timezones are validated at plan time, also on hosts without a timezone database.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/autonomous"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PowerScheduleResource{}
+var _ resource.ResourceWithConfigure = &PowerScheduleResource{}
+var _ resource.ResourceWithImportState = &PowerScheduleResource{}
+var _ resource.ResourceWithModifyPlan = &PowerScheduleResource{}
+
+func NewPowerScheduleResource() resource.Resource {
+	return &PowerScheduleResource{}
+}
+
+// PowerScheduleResource defines the resource implementation.
+type PowerScheduleResource struct {
+	client *skysql.Client
+}
+
+// PowerScheduleResourceModel describes the resource data model.
+type PowerScheduleResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ServiceID     types.String `tfsdk:"service_id"`
+	StopSchedule  types.String `tfsdk:"stop_schedule"`
+	StartSchedule types.String `tfsdk:"start_schedule"`
+	Timezone      types.String `tfsdk:"timezone"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	ActionID      types.String `tfsdk:"action_id"`
+}
+
+func (r *PowerScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_power_schedule"
+}
+
+func (r *PowerScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Stops and starts a service on a schedule, for example to stop a non-production service in the evening and start it in the morning. " +
+			"The schedule runs as an autonomous action of the service. Serverless services cannot be stopped and do not support a power schedule. " +
+			"Set ignore_is_active = true on the skysql_service so that it does not plan to undo the scheduled power changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to stop and start",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stop_schedule": schema.StringAttribute{
+				Required:    true,
+				Description: "A cron expression with five fields (minute, hour, day of month, month, day of week) for when to stop the service, for example 0 20 * * MON-FRI",
+			},
+			"start_schedule": schema.StringAttribute{
+				Required:    true,
+				Description: "A cron expression with five fields (minute, hour, day of month, month, day of week) for when to start the service, for example 0 7 * * MON-FRI",
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Description: "The IANA timezone the schedules are evaluated in, for example Europe/Helsinki. Default is UTC",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the schedule is active. Set it to false to pause the schedule without removing it. Default is true",
+			},
+			"action_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the autonomous action that runs the schedule",
+			},
+		},
+	}
+}
+
+func (r *PowerScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PowerScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PowerScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := r.setSchedule(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating power schedule",
+			fmt.Sprintf("Unable to create the power schedule of service %q: %s", data.ServiceID.ValueString(), err))
+		return
+	}
+
+	data.ID = data.ServiceID
+	resp.Diagnostics.Append(powerScheduleToState(action, &data)...)
+
+	tflog.Trace(ctx, "created power schedule resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PowerScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PowerScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ID.ValueString()
+
+	_, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing power schedule from state", map[string]interface{}{
+				"id": serviceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service", err.Error())
+		return
+	}
+
+	actions, err := r.client.GetAutonomousActions(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading power schedule", err.Error())
+		return
+	}
+
+	action := findPowerScheduleAction(actions)
+	if action == nil {
+		tflog.Warn(ctx, "SkySQL power schedule not found, removing from state", map[string]interface{}{
+			"id": serviceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ServiceID = types.StringValue(serviceID)
+	resp.Diagnostics.Append(powerScheduleToState(action, &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PowerScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PowerScheduleResourceModel
+	var state PowerScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	action, err := r.setSchedule(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating power schedule",
+			fmt.Sprintf("Unable to update the power schedule of service %q: %s", state.ServiceID.ValueString(), err))
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(powerScheduleToState(action, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PowerScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PowerScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAutonomousAction(ctx, state.ActionID.ValueString())
+	if err != nil && !errors.Is(err, skysql.ErrorServiceNotFound) {
+		resp.Diagnostics.AddError("Error deleting power schedule",
+			fmt.Sprintf("Unable to delete the power schedule of service %q: %s", state.ServiceID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted power schedule resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *PowerScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+func (r *PowerScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Plan does not need to be modified when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PowerScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, schedule := range []struct {
+		name  string
+		value types.String
+	}{
+		{name: "stop_schedule", value: plan.StopSchedule},
+		{name: "start_schedule", value: plan.StartSchedule},
+	} {
+		if schedule.value.IsUnknown() {
+			continue
+		}
+		if err := validateCronExpression(schedule.value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(schedule.name), "Invalid configuration", err.Error())
+		}
+	}
+
+	if !plan.StopSchedule.IsUnknown() && plan.StopSchedule.Equal(plan.StartSchedule) {
+		resp.Diagnostics.AddAttributeError(path.Root("start_schedule"),
+			"Invalid configuration",
+			"start_schedule must differ from stop_schedule")
+	}
+
+	if !plan.Timezone.IsUnknown() {
+		if _, err := time.LoadLocation(plan.Timezone.ValueString()); err != nil || plan.Timezone.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("timezone"),
+				"Invalid configuration",
+				fmt.Sprintf("%q is not an IANA timezone, such as UTC or Europe/Helsinki", plan.Timezone.ValueString()))
+		}
+	}
+}
+
+// setSchedule checks that the service can be stopped and creates or replaces its power schedule.
+func (r *PowerScheduleResource) setSchedule(ctx context.Context, data *PowerScheduleResourceModel) (*autonomous.ActionResponse, error) {
+	serviceID := data.ServiceID.ValueString()
+
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validatePowerScheduleTopology(service); err != nil {
+		return nil, err
+	}
+
+	actions, err := r.client.SetAutonomousActions(ctx, autonomous.SetAutonomousActionsRequest{
+		ServiceID:   serviceID,
+		ServiceName: service.Name,
+		Actions: []autonomous.AutoScaleAction{
+			autonomous.NewPowerScheduleAction(
+				data.StopSchedule.ValueString(),
+				data.StartSchedule.ValueString(),
+				data.Timezone.ValueString(),
+				data.Enabled.ValueBool(),
+			),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := findPowerScheduleAction(actions)
+	if action == nil {
+		return nil, errors.New("the power schedule is missing from the response")
+	}
+	return action, nil
+}
+
+// validatePowerScheduleTopology rejects services that cannot be stopped and started.
+func validatePowerScheduleTopology(service *provisioning.Service) error {
+	if service.Topology == "serverless-standalone" {
+		return fmt.Errorf("service %q has the serverless-standalone topology, which does not support stop and start", service.ID)
+	}
+	return nil
+}
+
+func findPowerScheduleAction(actions []autonomous.ActionResponse) *autonomous.ActionResponse {
+	for i := range actions {
+		if actions[i].Group == autonomous.PowerScheduleActionGroup {
+			return &actions[i]
+		}
+	}
+	return nil
+}
+
+func powerScheduleToState(action *autonomous.ActionResponse, data *PowerScheduleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	params := autonomous.PowerScheduleActionParams{}
+	if err := json.Unmarshal(action.Params, &params); err != nil {
+		diags.AddError("Error reading power schedule", err.Error())
+		return diags
+	}
+
+	data.ActionID = types.StringValue(action.ID)
+	data.Enabled = types.BoolValue(action.Enabled)
+	data.StopSchedule = types.StringValue(params.StopSchedule)
+	data.StartSchedule = types.StringValue(params.StartSchedule)
+	data.Timezone = types.StringValue(params.Timezone)
+	return diags
+}
+
+// cronField describes the values allowed in one field of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// validateCronExpression checks a cron expression with five fields. Each field is *, a value,
+// a range or a comma-separated list of those, optionally with a /step.
+func validateCronExpression(expression string) error {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("%q is not a cron expression: expected 5 fields (minute, hour, day of month, month, day of week), got %d",
+			expression, len(fields))
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return fmt.Errorf("%q is not a cron expression: %s", expression, err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) validate(field string) error {
+	for _, part := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 {
+				return fmt.Errorf("invalid step %q in %s field", step, f.name)
+			}
+		}
+		if base == "*" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(base, "-")
+		first, err := f.value(from)
+		if err != nil {
+			return err
+		}
+		if isRange {
+			last, err := f.value(to)
+			if err != nil {
+				return err
+			}
+			if first > last {
+				return fmt.Errorf("invalid range %q in %s field", base, f.name)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return i + f.min, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, expected %d-%d", value, f.name, f.min, f.max)
+	}
+	return n, nil
+}
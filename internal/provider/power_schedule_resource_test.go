@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/autonomous"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func powerScheduleTestConfig(serviceID string, stopSchedule string, startSchedule string, extra string) string {
+	return fmt.Sprintf(`
+	resource "skysql_power_schedule" "this" {
+		service_id     = %q
+		stop_schedule  = %q
+		start_schedule = %q
+		%s
+	}`, serviceID, stopSchedule, startSchedule, extra)
+}
+
+func TestPowerScheduleResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002600"
+	const actionID = "9c1f3f0e-3a55-4b55-a1a4-7c0e6b0d2f61"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	var actions []autonomous.ActionResponse
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	getActions := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/als/v1/actions", req.URL.Path)
+		r.Equal("service_id="+serviceID, req.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(actions)
+	}
+	setActions := func(stopSchedule string, timezone string, enabled bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPost, req.Method)
+			r.Equal("/als/v1/actions", req.URL.Path)
+
+			var payload autonomous.SetAutonomousActionsRequest
+			r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+			r.Equal(serviceID, payload.ServiceID)
+			r.Equal(service.Name, payload.ServiceName)
+			r.Len(payload.Actions, 1)
+			r.Equal(autonomous.PowerScheduleActionGroup, payload.Actions[0].Group)
+			r.Equal(enabled, payload.Actions[0].Enabled)
+
+			var params autonomous.PowerScheduleActionParams
+			r.NoError(json.Unmarshal(payload.Actions[0].Params, &params))
+			r.Equal(stopSchedule, params.StopSchedule)
+			r.Equal("0 7 * * MON-FRI", params.StartSchedule)
+			r.Equal(timezone, params.Timezone)
+
+			actions = []autonomous.ActionResponse{{
+				ID:          actionID,
+				Group:       payload.Actions[0].Group,
+				Enabled:     payload.Actions[0].Enabled,
+				Params:      payload.Actions[0].Params,
+				ServiceID:   serviceID,
+				ServiceName: payload.ServiceName,
+			}}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(actions)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the schedule is created with the default timezone
+	expectRequest(getService)
+	expectRequest(setActions("0 20 * * MON-FRI", "UTC", true))
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getActions)
+	expectRequest(getService)
+	expectRequest(getActions)
+	// Step 2: the schedule is changed and paused
+	expectRequest(getService)
+	expectRequest(setActions("30 18 * * 1-5", "Europe/Helsinki", false))
+	// Refresh after apply
+	expectRequest(getService)
+	expectRequest(getActions)
+	// Import reads the service and its actions
+	expectRequest(getService)
+	expectRequest(getActions)
+	// Destroy
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/als/v1/actions/"+actionID, req.URL.Path)
+		actions = nil
+		w.WriteHeader(http.StatusOK)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: powerScheduleTestConfig(serviceID, "0 20 * * MON-FRI", "0 7 * * MON-FRI", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "action_id", actionID),
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "enabled", "true"),
+				),
+			},
+			{
+				Config: powerScheduleTestConfig(serviceID, "30 18 * * 1-5", "0 7 * * MON-FRI", `
+					timezone = "Europe/Helsinki"
+					enabled  = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "stop_schedule", "30 18 * * 1-5"),
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "timezone", "Europe/Helsinki"),
+					resource.TestCheckResourceAttr("skysql_power_schedule.this", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "skysql_power_schedule.this",
+				ImportState:       true,
+				ImportStateId:     serviceID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPowerScheduleResource_Validation(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002601"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// The serverless service is rejected when the schedule is created
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+
+		service := restoreTestService(serviceID)
+		service.Topology = "serverless-standalone"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      powerScheduleTestConfig(serviceID, "0 25 * * *", "0 7 * * *", ""),
+				ExpectError: regexp.MustCompile(`invalid value "25" in hour field`),
+			},
+			{
+				Config:      powerScheduleTestConfig(serviceID, "0 20 * *", "0 7 * * *", ""),
+				ExpectError: regexp.MustCompile(`expected 5 fields`),
+			},
+			{
+				Config:      powerScheduleTestConfig(serviceID, "0 7 * * *", "0 7 * * *", ""),
+				ExpectError: regexp.MustCompile(`start_schedule must differ from stop_schedule`),
+			},
+			{
+				Config:      powerScheduleTestConfig(serviceID, "0 20 * * *", "0 7 * * *", `timezone = "Mars/Olympus"`),
+				ExpectError: regexp.MustCompile(`"Mars/Olympus" is not an IANA timezone`),
+			},
+			{
+				Config:      powerScheduleTestConfig(serviceID, "0 20 * * *", "0 7 * * *", ""),
+				ExpectError: regexp.MustCompile(`serverless-standalone topology`),
+			},
+		},
+	})
+}
@@ -0,0 +1,614 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+var (
+	rxAWSAccountID        = regexp.MustCompile(`^[0-9]{12}$`)
+	rxGCPProjectID        = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	rxGCPProjectNumber    = regexp.MustCompile(`^[0-9]{1,19}$`)
+	rxAzureSubscriptionID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PrivateEndpointResource{}
+var _ resource.ResourceWithConfigure = &PrivateEndpointResource{}
+var _ resource.ResourceWithImportState = &PrivateEndpointResource{}
+var _ resource.ResourceWithModifyPlan = &PrivateEndpointResource{}
+
+func NewPrivateEndpointResource() resource.Resource {
+	return &PrivateEndpointResource{}
+}
+
+// PrivateEndpointResource defines the resource implementation.
+type PrivateEndpointResource struct {
+	client *skysql.Client
+}
+
+// PrivateEndpointResourceModel describes the resource data model.
+type PrivateEndpointResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	ServiceID             types.String   `tfsdk:"service_id"`
+	Name                  types.String   `tfsdk:"name"`
+	CloudProvider         types.String   `tfsdk:"cloud_provider"`
+	AllowedAccounts       types.List     `tfsdk:"allowed_accounts"`
+	Mechanism             types.String   `tfsdk:"mechanism"`
+	EndpointService       types.String   `tfsdk:"endpoint_service"`
+	AWSServiceName        types.String   `tfsdk:"aws_vpc_endpoint_service_name"`
+	GCPServiceAttachment  types.String   `tfsdk:"gcp_service_attachment"`
+	AzurePrivateLinkAlias types.String   `tfsdk:"azure_private_link_service_alias"`
+	FQDN                  types.String   `tfsdk:"fqdn"`
+	ReadWritePort         types.Int64    `tfsdk:"read_write_port"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *PrivateEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_endpoint"
+}
+
+func (r *PrivateEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a private endpoint of a service: AWS PrivateLink, GCP Private Service Connect or Azure Private Link. " +
+			"The endpoint is added next to the other endpoints of the service and removed when the resource is destroyed. " +
+			"When it is the only endpoint of the service, destroying the resource only removes it from the Terraform state and the endpoint stays private. " +
+			"Do not manage the same endpoint through the endpoints attribute of skysql_service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the private endpoint, in the form <service_id>/<name>",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to add the private endpoint to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("private"),
+				Description: "The name of the endpoint. It must not be used by another endpoint of the service. " +
+					"Import an existing endpoint, for example the primary endpoint created with the service, to manage it instead. Default is private",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Required:    true,
+				Description: "The cloud provider of the service. Valid values are: aws, gcp or azure. It must match the cloud provider of the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp", "azure"),
+				},
+			},
+			"allowed_accounts": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The cloud accounts that are allowed to connect to the endpoint: 12-digit AWS account IDs, GCP project IDs or project numbers, or Azure subscription IDs. " +
+					"Changing the list updates the endpoint in place",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"mechanism": schema.StringAttribute{
+				Computed:    true,
+				Description: "The endpoint mechanism: privatelink on aws, privateconnect on gcp and azure",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_service": schema.StringAttribute{
+				Computed:    true,
+				Description: "The endpoint service of the endpoint that private connections are made to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_vpc_endpoint_service_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service name to use as service_name of an aws_vpc_endpoint. Null when cloud_provider is not aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gcp_service_attachment": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service attachment to use as target of a google_compute_forwarding_rule. Null when cloud_provider is not gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"azure_private_link_service_alias": schema.StringAttribute{
+				Computed: true,
+				Description: "The alias to use as private_connection_resource_alias of an azurerm_private_endpoint. " +
+					"Null when cloud_provider is not azure",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The fully qualified domain name of the service, to resolve to the private address in a private DNS zone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"read_write_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The read-write port of the endpoint",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *PrivateEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PrivateEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service",
+			fmt.Sprintf("Unable to read service %q: %s", serviceID, err))
+		return
+	}
+
+	if service.Provider != data.CloudProvider.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("cloud_provider"),
+			"Invalid configuration",
+			fmt.Sprintf("The service %q runs on %s, not on %s", serviceID, service.Provider, data.CloudProvider.ValueString()))
+		return
+	}
+
+	if findEndpoint(service.Endpoints, data.Name.ValueString()) != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"),
+			"Endpoint already exists",
+			fmt.Sprintf("The service %q already has an endpoint named %q. Choose another name, "+
+				"or import the endpoint with the ID %s/%s to manage it", serviceID, data.Name.ValueString(), serviceID, data.Name.ValueString()))
+		return
+	}
+
+	if err := r.modifyEndpoint(ctx, service, &data); err != nil {
+		resp.Diagnostics.AddError("Error creating private endpoint",
+			fmt.Sprintf("Unable to create private endpoint %q for service %q: %s", data.Name.ValueString(), serviceID, err))
+		return
+	}
+
+	data.ID = types.StringValue(serviceID + "/" + data.Name.ValueString())
+
+	tflog.Trace(ctx, "created private endpoint resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	// The endpoint exists from now on, so it is saved before waiting for the service to be ready.
+	// The attributes that are only known once the service is ready are read after the wait.
+	data.EndpointService = types.StringNull()
+	data.AWSServiceName = types.StringNull()
+	data.GCPServiceAttachment = types.StringNull()
+	data.AzurePrivateLinkAlias = types.StringNull()
+	data.FQDN = types.StringNull()
+	data.ReadWritePort = types.Int64Null()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err = waitForServiceReady(ctx, r.client, serviceID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating private endpoint",
+			fmt.Sprintf("Service %q did not become ready after adding private endpoint %q: %s", serviceID, data.Name.ValueString(), err))
+		return
+	}
+
+	endpoint := findEndpoint(service.Endpoints, data.Name.ValueString())
+	if endpoint == nil {
+		resp.Diagnostics.AddError("Error creating private endpoint",
+			fmt.Sprintf("The endpoint %q was not found on service %q", data.Name.ValueString(), serviceID))
+		return
+	}
+	resp.Diagnostics.Append(privateEndpointToState(ctx, service, endpoint, &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, name, ok := strings.Cut(data.ID.ValueString(), "/")
+	if !ok {
+		resp.Diagnostics.AddError("Invalid private endpoint ID",
+			fmt.Sprintf("Expected an ID in the form <service_id>/<name>, got %q", data.ID.ValueString()))
+		return
+	}
+
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing private endpoint from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service", err.Error())
+		return
+	}
+
+	endpoint := findEndpoint(service.Endpoints, name)
+	if endpoint == nil || !Contains[string](privateConnectMechanisms, endpoint.Mechanism) {
+		tflog.Warn(ctx, "SkySQL private endpoint not found, removing from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ServiceID = types.StringValue(serviceID)
+	data.Name = types.StringValue(name)
+	data.CloudProvider = types.StringValue(service.Provider)
+	resp.Diagnostics.Append(privateEndpointToState(ctx, service, endpoint, &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PrivateEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PrivateEndpointResourceModel
+	var state PrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := state.ServiceID.ValueString()
+
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service",
+			fmt.Sprintf("Unable to read service %q: %s", serviceID, err))
+		return
+	}
+
+	if err := r.modifyEndpoint(ctx, service, &plan); err != nil {
+		resp.Diagnostics.AddError("Error updating private endpoint",
+			fmt.Sprintf("Unable to update private endpoint %q for service %q: %s", plan.Name.ValueString(), serviceID, err))
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err = waitForServiceReady(ctx, r.client, serviceID, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating private endpoint",
+			fmt.Sprintf("Service %q did not become ready after updating private endpoint %q: %s", serviceID, plan.Name.ValueString(), err))
+		return
+	}
+
+	endpoint := findEndpoint(service.Endpoints, plan.Name.ValueString())
+	if endpoint == nil {
+		resp.Diagnostics.AddError("Error updating private endpoint",
+			fmt.Sprintf("The endpoint %q was not found on service %q", plan.Name.ValueString(), serviceID))
+		return
+	}
+	resp.Diagnostics.Append(privateEndpointToState(ctx, service, endpoint, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PrivateEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PrivateEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := state.ServiceID.ValueString()
+	name := state.Name.ValueString()
+
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service",
+			fmt.Sprintf("Unable to read service %q: %s", serviceID, err))
+		return
+	}
+
+	if findEndpoint(service.Endpoints, name) == nil {
+		return
+	}
+
+	endpoints := make([]provisioning.ServiceEndpoint, 0, len(service.Endpoints))
+	for _, endpoint := range service.Endpoints {
+		if endpoint.Name != name {
+			endpoints = append(endpoints, serviceEndpointFromEndpoint(endpoint))
+		}
+	}
+	// A service always has at least one endpoint. Its last endpoint is left as it is
+	// rather than made public, and only removed from the state.
+	if len(endpoints) == 0 {
+		resp.Diagnostics.AddWarning("Private endpoint not removed",
+			fmt.Sprintf("The endpoint %q is the only endpoint of service %q, so it was not removed from the service. "+
+				"It was removed from the Terraform state and stays private.", name, serviceID))
+		return
+	}
+
+	if _, err := r.client.ModifyServiceEndpoints(ctx, serviceID, endpoints); err != nil {
+		resp.Diagnostics.AddError("Error deleting private endpoint",
+			fmt.Sprintf("Unable to delete private endpoint %q for service %q: %s", name, serviceID, err))
+		return
+	}
+
+	if _, err := waitForServiceReady(ctx, r.client, serviceID, deleteTimeout); err != nil {
+		resp.Diagnostics.AddError("Error deleting private endpoint",
+			fmt.Sprintf("Service %q did not become ready after removing private endpoint %q: %s", serviceID, name, err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted private endpoint resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *PrivateEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected an ID in the form <service_id>/<name>, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *PrivateEndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PrivateEndpointResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CloudProvider.IsUnknown() {
+		return
+	}
+	cloudProvider := plan.CloudProvider.ValueString()
+
+	if plan.Mechanism.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mechanism"), privateEndpointMechanism(cloudProvider))...)
+	}
+
+	if plan.AllowedAccounts.IsUnknown() {
+		return
+	}
+
+	for i, account := range plan.AllowedAccounts.Elements() {
+		value, ok := account.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if err := validateAllowedAccount(cloudProvider, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("allowed_accounts").AtListIndex(i),
+				"Invalid allowed account", err.Error())
+		}
+	}
+}
+
+// modifyEndpoint adds the endpoint to the endpoints of the service, or replaces the endpoint
+// with the same name. The service is not ready again until the change is applied.
+func (r *PrivateEndpointResource) modifyEndpoint(
+	ctx context.Context,
+	service *provisioning.Service,
+	data *PrivateEndpointResourceModel,
+) error {
+	var allowedAccounts []string
+	if d := data.AllowedAccounts.ElementsAs(ctx, &allowedAccounts, false); d.HasError() {
+		return errors.New("unable to read allowed_accounts")
+	}
+
+	endpoint := provisioning.ServiceEndpoint{
+		Name:            data.Name.ValueString(),
+		Mechanism:       privateEndpointMechanism(data.CloudProvider.ValueString()),
+		AllowedAccounts: allowedAccounts,
+		Visibility:      visibilityPrivate,
+	}
+
+	endpoints := make([]provisioning.ServiceEndpoint, 0, len(service.Endpoints)+1)
+	replaced := false
+	for _, existing := range service.Endpoints {
+		if existing.Name == endpoint.Name {
+			endpoints = append(endpoints, endpoint)
+			replaced = true
+			continue
+		}
+		endpoints = append(endpoints, serviceEndpointFromEndpoint(existing))
+	}
+	if !replaced {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	_, err := r.client.ModifyServiceEndpoints(ctx, service.ID, endpoints)
+	return err
+}
+
+// privateEndpointMechanism returns the endpoint mechanism SkySQL uses for private connectivity on a cloud provider.
+func privateEndpointMechanism(cloudProvider string) string {
+	if cloudProvider == "aws" {
+		return "privatelink"
+	}
+	return "privateconnect"
+}
+
+// validateAllowedAccount checks that account has the format of an account of the cloud provider.
+func validateAllowedAccount(cloudProvider string, account string) error {
+	switch cloudProvider {
+	case "aws":
+		if !rxAWSAccountID.MatchString(account) {
+			return fmt.Errorf("%q is not an AWS account ID. AWS account IDs are made of 12 digits", account)
+		}
+	case "gcp":
+		if !rxGCPProjectID.MatchString(account) && !rxGCPProjectNumber.MatchString(account) {
+			return fmt.Errorf("%q is not a GCP project ID or project number. Project IDs are 6 to 30 lowercase letters, digits or hyphens, starting with a letter", account)
+		}
+	case "azure":
+		if !rxAzureSubscriptionID.MatchString(account) {
+			return fmt.Errorf("%q is not an Azure subscription ID. Subscription IDs are GUIDs, for example 00000000-0000-0000-0000-000000000000", account)
+		}
+	}
+	return nil
+}
+
+func findEndpoint(endpoints []provisioning.Endpoint, name string) *provisioning.Endpoint {
+	for i := range endpoints {
+		if endpoints[i].Name == name {
+			return &endpoints[i]
+		}
+	}
+	return nil
+}
+
+// serviceEndpointFromEndpoint converts an endpoint of a service into its form in an endpoints request.
+func serviceEndpointFromEndpoint(endpoint provisioning.Endpoint) provisioning.ServiceEndpoint {
+	return provisioning.ServiceEndpoint{
+		Name:            endpoint.Name,
+		Mechanism:       endpoint.Mechanism,
+		AllowedAccounts: endpoint.AllowedAccounts,
+		Visibility:      endpointVisibilityValue(endpoint.Visibility, endpoint.Mechanism).ValueString(),
+		AllowList:       endpoint.AllowList,
+	}
+}
+
+func privateEndpointToState(ctx context.Context, service *provisioning.Service, endpoint *provisioning.Endpoint, data *PrivateEndpointResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Mechanism = types.StringValue(endpoint.Mechanism)
+	data.AllowedAccounts, diags = types.ListValueFrom(ctx, types.StringType, endpoint.AllowedAccounts)
+
+	data.EndpointService = types.StringNull()
+	data.AWSServiceName = types.StringNull()
+	data.GCPServiceAttachment = types.StringNull()
+	data.AzurePrivateLinkAlias = types.StringNull()
+	if endpoint.EndpointService != "" {
+		data.EndpointService = types.StringValue(endpoint.EndpointService)
+		switch service.Provider {
+		case "aws":
+			data.AWSServiceName = data.EndpointService
+		case "gcp":
+			data.GCPServiceAttachment = data.EndpointService
+		case "azure":
+			data.AzurePrivateLinkAlias = data.EndpointService
+		}
+	}
+
+	data.FQDN = types.StringNull()
+	if service.FQDN != "" {
+		data.FQDN = types.StringValue(service.FQDN)
+	}
+	data.ReadWritePort = types.Int64Null()
+	for _, port := range endpoint.Ports {
+		if port.Purpose == provisioning.PortPurposeReadWrite {
+			data.ReadWritePort = types.Int64Value(int64(port.Port))
+			break
+		}
+	}
+	return diags
+}
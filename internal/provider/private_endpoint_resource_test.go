@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateEndpointResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002520"
+	const endpointService = "com.amazonaws.vpce.us-east-2.vpce-svc-0123456789abcdef0"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Provider = "aws"
+	service.FQDN = serviceID + ".sysp0000.db1.skysql.com"
+	service.Endpoints[0].Mechanism = "nlb"
+	service.Endpoints[0].Visibility = "public"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	patchEndpoints := func(check func(r *require.Assertions, payload provisioning.PatchServiceEndpointsRequest)) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPatch, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID+"/endpoints", req.URL.Path)
+
+			var payload provisioning.PatchServiceEndpointsRequest
+			r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+			check(r, payload)
+
+			service.Endpoints = service.Endpoints[:0]
+			for _, endpoint := range payload {
+				updated := provisioning.Endpoint{
+					Name:            endpoint.Name,
+					Mechanism:       endpoint.Mechanism,
+					AllowedAccounts: endpoint.AllowedAccounts,
+					Visibility:      endpoint.Visibility,
+					Ports:           []provisioning.Port{{Name: "readwrite", Port: 3306, Purpose: provisioning.PortPurposeReadWrite}},
+				}
+				if endpoint.Mechanism == "privatelink" {
+					updated.EndpointService = endpointService
+				}
+				service.Endpoints = append(service.Endpoints, updated)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(payload)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the private endpoint is added next to the public one
+	expectRequest(getService)
+	expectRequest(patchEndpoints(func(r *require.Assertions, payload provisioning.PatchServiceEndpointsRequest) {
+		r.Len(payload, 2)
+		r.Equal("primary", payload[0].Name)
+		r.Equal("nlb", payload[0].Mechanism)
+		r.Equal("public", payload[0].Visibility)
+		r.Equal("private", payload[1].Name)
+		r.Equal("privatelink", payload[1].Mechanism)
+		r.Equal("private", payload[1].Visibility)
+		r.Equal([]string{"123456789012"}, payload[1].AllowedAccounts)
+	}))
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the allowed accounts are updated in place
+	expectRequest(getService)
+	expectRequest(patchEndpoints(func(r *require.Assertions, payload provisioning.PatchServiceEndpointsRequest) {
+		r.Len(payload, 2)
+		r.Equal("primary", payload[0].Name)
+		r.Equal("private", payload[1].Name)
+		r.Equal([]string{"123456789012", "210987654321"}, payload[1].AllowedAccounts)
+	}))
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Destroy removes the private endpoint and keeps the public one
+	expectRequest(getService)
+	expectRequest(patchEndpoints(func(r *require.Assertions, payload provisioning.PatchServiceEndpointsRequest) {
+		r.Len(payload, 1)
+		r.Equal("primary", payload[0].Name)
+		r.Equal("nlb", payload[0].Mechanism)
+	}))
+	expectRequest(getService)
+
+	config := func(accounts string) string {
+		return `
+		resource "skysql_private_endpoint" "this" {
+			service_id       = "` + serviceID + `"
+			cloud_provider   = "aws"
+			allowed_accounts = [` + accounts + `]
+		}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(`"123456789012"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "id", serviceID+"/private"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "mechanism", "privatelink"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "endpoint_service", endpointService),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "aws_vpc_endpoint_service_name", endpointService),
+					resource.TestCheckNoResourceAttr("skysql_private_endpoint.this", "gcp_service_attachment"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "fqdn", serviceID+".sysp0000.db1.skysql.com"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "read_write_port", "3306"),
+				),
+			},
+			{
+				Config: config(`"123456789012", "210987654321"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "allowed_accounts.#", "2"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "allowed_accounts.1", "210987654321"),
+					resource.TestCheckResourceAttr("skysql_private_endpoint.this", "endpoint_service", endpointService),
+				),
+			},
+		},
+	})
+}
+
+func TestPrivateEndpointResource_NameInUse(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002521"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Provider = "aws"
+	service.Endpoints = append(service.Endpoints, provisioning.Endpoint{
+		Name:            "private",
+		Mechanism:       "privatelink",
+		AllowedAccounts: []string{"123456789012"},
+		Visibility:      "private",
+	})
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// The existing endpoint is not taken over, so no endpoints request is made
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_private_endpoint" "this" {
+					service_id       = "` + serviceID + `"
+					cloud_provider   = "aws"
+					allowed_accounts = ["210987654321"]
+				}`,
+				ExpectError: regexp.MustCompile(`already has an endpoint named "private"`),
+			},
+		},
+	})
+}
+
+func TestPrivateEndpointResource_LastEndpoint(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002522"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Provider = "aws"
+	service.Endpoints[0].Mechanism = "nlb"
+	service.Endpoints[0].Visibility = "public"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPatch, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/endpoints", req.URL.Path)
+
+		var payload provisioning.PatchServiceEndpointsRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Len(payload, 2)
+
+		service.Endpoints = append(service.Endpoints, provisioning.Endpoint{
+			Name:            payload[1].Name,
+			Mechanism:       payload[1].Mechanism,
+			AllowedAccounts: payload[1].AllowedAccounts,
+			Visibility:      payload[1].Visibility,
+		})
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	})
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// The public endpoint is removed outside of Terraform, so the private endpoint is the last one.
+	// Destroy leaves it private on the service instead of making it public.
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		service.Endpoints = service.Endpoints[1:]
+		getService(w, req)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_private_endpoint" "this" {
+					service_id       = "` + serviceID + `"
+					cloud_provider   = "aws"
+					allowed_accounts = ["123456789012"]
+				}`,
+				Check: resource.TestCheckResourceAttr("skysql_private_endpoint.this", "id", serviceID+"/private"),
+			},
+		},
+	})
+}
+
+func TestPrivateEndpointResource_InvalidAccounts(t *testing.T) {
+	tests := []struct {
+		cloudProvider string
+		account       string
+		expectError   string
+	}{
+		{cloudProvider: "aws", account: "12345678901", expectError: `is not an AWS account ID`},
+		{cloudProvider: "gcp", account: "My_Project", expectError: `is not a GCP project ID`},
+		{cloudProvider: "azure", account: "123456789012", expectError: `is not an Azure subscription ID`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cloudProvider, func(t *testing.T) {
+			configureOnce.Reset()
+
+			testUrl, expectRequest, close := mockSkySQLAPI(t)
+			defer close()
+			os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+			os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+			expectRequest(func(w http.ResponseWriter, req *http.Request) {
+				r := require.New(t)
+				r.Equal(http.MethodGet, req.Method)
+				r.Equal("/provisioning/v1/versions", req.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode([]provisioning.Version{})
+			})
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+					"skysql": providerserver.NewProtocol6WithError(New("")()),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: `
+						resource "skysql_private_endpoint" "this" {
+							service_id       = "dbdgf42002520"
+							cloud_provider   = "` + test.cloudProvider + `"
+							allowed_accounts = ["` + test.account + `"]
+						}`,
+						ExpectError: regexp.MustCompile(test.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestValidateAllowedAccount(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(validateAllowedAccount("aws", "123456789012"))
+	r.NoError(validateAllowedAccount("gcp", "my-project-123"))
+	r.NoError(validateAllowedAccount("gcp", "123456789012"))
+	r.NoError(validateAllowedAccount("azure", "0f8fad5b-d9cb-469f-a165-70867728950e"))
+
+	r.Error(validateAllowedAccount("aws", "1234-5678-9012"))
+	r.Error(validateAllowedAccount("gcp", "proj"))
+	r.Error(validateAllowedAccount("azure", "0f8fad5bd9cb469fa16570867728950e"))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/matryer/resync"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,6 +18,7 @@ import (
 
 // Ensure skySQLProvider satisfies various provider interfaces.
 var _ provider.Provider = &skySQLProvider{}
+var _ provider.ProviderWithActions = &skySQLProvider{}
 
 var configureOnce resync.Once
 
@@ -141,6 +143,7 @@ func (p *skySQLProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
 }
 
 func (p *skySQLProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -149,6 +152,17 @@ func (p *skySQLProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewServiceAllowListResource,
 		NewAutonomousResource,
 		NewConfigResource,
+		NewBackupResource,
+		NewServiceCloneResource,
+		NewBackupRestoreResource,
+		NewReplicationPromotionResource,
+		NewExternalReplicationResource,
+		NewGlobalClusterResource,
+		NewPrivateEndpointResource,
+		NewVPCPeeringResource,
+		NewServicePowerStateResource,
+		NewPowerScheduleResource,
+		NewServiceRestartResource,
 	}
 }
 
@@ -159,6 +173,16 @@ func (p *skySQLProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewServiceDataSource,
 		NewCredentialsDataSource,
 		NewAvailabilityZonesDataSource,
+		NewBackupsDataSource,
+	}
+}
+
+// Actions are invoked from lifecycle action triggers or with terraform apply -invoke, which needs Terraform 1.14 or later.
+func (p *skySQLProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRestartServiceAction,
+		NewSwitchoverAction,
+		NewCreateBackupAction,
 	}
 }
 
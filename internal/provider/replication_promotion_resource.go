@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReplicationPromotionResource{}
+var _ resource.ResourceWithConfigure = &ReplicationPromotionResource{}
+
+func NewReplicationPromotionResource() resource.Resource {
+	return &ReplicationPromotionResource{}
+}
+
+// ReplicationPromotionResource defines the resource implementation.
+type ReplicationPromotionResource struct {
+	client *skysql.Client
+}
+
+// ReplicationPromotionResourceModel describes the resource data model.
+type ReplicationPromotionResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	ServiceID        types.String   `tfsdk:"service_id"`
+	PrimaryServiceID types.String   `tfsdk:"primary_service_id"`
+	Mode             types.String   `tfsdk:"mode"`
+	Triggers         types.Map      `tfsdk:"triggers"`
+	PromotedAt       types.String   `tfsdk:"promoted_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ReplicationPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_promotion"
+}
+
+func (r *ReplicationPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes a replica service to a standalone primary, or performs a planned switchover between a replica and its primary, " +
+			"and waits for both services to be ready. " +
+			"The promotion runs when the resource is created and again whenever triggers change. " +
+			"Destroying the resource only removes it from the Terraform state. " +
+			"The skysql_service resources keep the replication_enabled and primary_host they were created with, so the promotion does not replace them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the promotion. Same as service_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the replica service to promote",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service the replica currently replicates from",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(provisioning.PromotionModePromote),
+				Description: "How to promote the replica. Valid values are: promote or switchover. " +
+					"promote detaches the replica and makes it a standalone primary. " +
+					"switchover makes the replica the primary and the former primary a replica of it. Default is promote",
+				Validators: []validator.String{
+					stringvalidator.OneOf(provisioning.PromotionModePromote, provisioning.PromotionModeSwitchover),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that, when changed, run the promotion again",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"promoted_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the promotion was requested, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ReplicationPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ReplicationPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReplicationPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	primaryServiceID := data.PrimaryServiceID.ValueString()
+
+	if serviceID == primaryServiceID {
+		resp.Diagnostics.AddAttributeError(path.Root("primary_service_id"),
+			"Invalid configuration",
+			"primary_service_id must be different from service_id")
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Promoting replica service", map[string]interface{}{
+		"service_id":         serviceID,
+		"primary_service_id": primaryServiceID,
+		"mode":               data.Mode.ValueString(),
+	})
+
+	err := r.client.PromoteService(ctx, serviceID, &provisioning.ReplicationPromotionRequest{
+		Mode:             data.Mode.ValueString(),
+		PrimaryServiceID: primaryServiceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error promoting replica",
+			fmt.Sprintf("Unable to promote service %q: %s", serviceID, err))
+		return
+	}
+
+	data.ID = types.StringValue(serviceID)
+	data.PromotedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	tflog.Trace(ctx, "created replication promotion resource", map[string]interface{}{
+		"id": serviceID,
+	})
+
+	// The promotion is saved as soon as it is requested, so a failed wait does not request it again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Both services restart their replication during a promotion, so wait for each of them to settle.
+	for _, id := range []string{serviceID, primaryServiceID} {
+		if _, err := waitForServiceReady(ctx, r.client, id, createTimeout); err != nil {
+			resp.Diagnostics.AddError("Error promoting replica",
+				fmt.Sprintf("Service %q did not return to ready state after the promotion: %s. "+
+					"The promotion was requested; untaint the resource to keep it from running again.", id, err))
+			return
+		}
+	}
+}
+
+func (r *ReplicationPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A promotion is a one-off operation; there is nothing to refresh.
+}
+
+func (r *ReplicationPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReplicationPromotionResourceModel
+	var state ReplicationPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute that affects the promotion forces replacement,
+	// so only the timeouts can change here.
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReplicationPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A promotion cannot be undone; removing the resource only drops it from state.
+	tflog.Trace(ctx, "deleted replication promotion resource")
+}
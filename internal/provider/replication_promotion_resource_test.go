@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationPromotionResource_Switchover(t *testing.T) {
+	configureOnce.Reset()
+
+	const primaryID = "dbdgf42002480"
+	const replicaID = "dbdgf42002481"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	getService := func(serviceID string, status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			service := restoreTestService(serviceID)
+			service.Status = status
+			json.NewEncoder(w).Encode(service)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+replicaID+"/replication/promote", req.URL.Path)
+
+		var payload provisioning.ReplicationPromotionRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(provisioning.PromotionModeSwitchover, payload.Mode)
+		r.Equal(primaryID, payload.PrimaryServiceID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+	// Wait for both services to settle
+	expectRequest(getService(replicaID, "pending_modifying"))
+	expectRequest(getService(replicaID, "ready"))
+	expectRequest(getService(primaryID, "ready"))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_replication_promotion" "drill" {
+					service_id         = "` + replicaID + `"
+					primary_service_id = "` + primaryID + `"
+					mode               = "switchover"
+					triggers = {
+						drill = "2026-q1"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_replication_promotion.drill", "id", replicaID),
+					resource.TestCheckResourceAttr("skysql_replication_promotion.drill", "mode", "switchover"),
+					resource.TestCheckResourceAttrSet("skysql_replication_promotion.drill", "promoted_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceReplication_PromotedReplicaKeepsPlan(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002482"
+	const primaryID = "dbdgf42002480"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-replica"
+	service.ReplicationEnabled = true
+	service.PrimaryHost = primaryID
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	getStatus := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/replication/status", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&provisioning.ReplicationStatus{State: provisioning.ReplicationStateRunning})
+	}
+	getPrimary := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+primaryID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(restoreTestService(primaryID))
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the replica is created
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.True(payload.ReplicationEnabled)
+		r.Equal(primaryID, payload.PrimaryHost)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	expectRequest(getStatus)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getStatus)
+	expectRequest(getService)
+	expectRequest(getStatus)
+	// Step 2: the replica is promoted, so it no longer replicates and has no primary host
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/replication/promote", req.URL.Path)
+
+		service.ReplicationEnabled = false
+		service.PrimaryHost = ""
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(getService)
+	expectRequest(getPrimary)
+	// Refresh after apply: the plan of the promoted service, without ignore_changes, stays empty
+	expectRequest(getService)
+	// Destroy
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	config := `
+		resource "skysql_service" "replica" {
+			service_type        = "transactional"
+			topology            = "es-single"
+			cloud_provider      = "gcp"
+			region              = "us-central1"
+			name                = "test-replica"
+			architecture        = "amd64"
+			nodes               = 1
+			size                = "sky-2x8"
+			storage             = 100
+			ssl_enabled         = true
+			version             = "10.6.11-6-1"
+			wait_for_creation   = true
+			wait_for_deletion   = false
+			deletion_protection = false
+			replication_enabled = true
+			primary_host        = "` + primaryID + `"
+		}`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.replica", "replication_enabled", "true"),
+					resource.TestCheckResourceAttr("skysql_service.replica", "primary_host", primaryID),
+				),
+			},
+			{
+				Config: config + `
+				resource "skysql_replication_promotion" "drill" {
+					service_id         = skysql_service.replica.id
+					primary_service_id = "` + primaryID + `"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.replica", "replication_enabled", "true"),
+					resource.TestCheckResourceAttr("skysql_service.replica", "primary_host", primaryID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RestartServiceAction{}
+var _ action.ActionWithConfigure = &RestartServiceAction{}
+
+func NewRestartServiceAction() action.Action {
+	return &RestartServiceAction{}
+}
+
+// RestartServiceAction defines the action implementation.
+type RestartServiceAction struct {
+	client *skysql.Client
+}
+
+// RestartServiceActionModel describes the action data model.
+type RestartServiceActionModel struct {
+	ServiceID types.String `tfsdk:"service_id"`
+	Rolling   types.Bool   `tfsdk:"rolling"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+func (a *RestartServiceAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restart_service"
+}
+
+func (a *RestartServiceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a running service and waits until it is ready again.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to restart",
+			},
+			"rolling": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to restart the nodes one at a time so that the service stays available. Only supported for replicated topologies. Default is false",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for the service to be ready again, as a duration such as 30m. Default is 60m",
+			},
+		},
+	}
+}
+
+func (a *RestartServiceAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *RestartServiceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RestartServiceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := actionTimeout(data.Timeout, defaultUpdateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid configuration", err.Error())
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restarting service %s", serviceID),
+	})
+
+	if _, err := restartService(ctx, a.client, serviceID, data.Rolling.ValueBool(), timeout); err != nil {
+		resp.Diagnostics.AddError("Error restarting service",
+			fmt.Sprintf("Unable to restart service %q: %s", serviceID, err))
+		return
+	}
+
+	tflog.Trace(ctx, "invoked restart service action", map[string]interface{}{
+		"service_id": serviceID,
+	})
+}
+
+// actionTimeout parses the optional timeout attribute of an action, which has no timeouts block.
+func actionTimeout(value types.String, defaultTimeout time.Duration) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be a positive duration such as 30m or 2h, got %q", value.ValueString())
+	}
+	return timeout, nil
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+// invokeTestAction configures the provider and invokes an action through the plugin protocol,
+// the way Terraform 1.14 does for action triggers and terraform apply -invoke. Attributes missing
+// from config are null. It returns the progress messages and the diagnostics of the validation
+// or the invocation.
+func invokeTestAction(t *testing.T, actionType string, config map[string]tftypes.Value) ([]string, []*tfprotov6.Diagnostic) {
+	ctx := context.Background()
+	r := require.New(t)
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	r.NoError(err)
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	r.NoError(err)
+	r.Empty(schemas.Diagnostics)
+
+	providerConfig := testDynamicValue(t, schemas.Provider.ValueType(), nil)
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.14.0",
+		Config:           &providerConfig,
+	})
+	r.NoError(err)
+	r.Empty(configured.Diagnostics)
+
+	actionSchema, ok := schemas.ActionSchemas[actionType]
+	r.True(ok, "action %s is not registered", actionType)
+
+	actionServer, ok := server.(tfprotov6.ActionServer)
+	r.True(ok, "the provider server does not serve actions")
+
+	actionConfig := testDynamicValue(t, actionSchema.Schema.ValueType(), config)
+	validated, err := actionServer.ValidateActionConfig(ctx, &tfprotov6.ValidateActionConfigRequest{
+		ActionType: actionType,
+		Config:     &actionConfig,
+	})
+	r.NoError(err)
+	if len(validated.Diagnostics) > 0 {
+		return nil, validated.Diagnostics
+	}
+
+	stream, err := actionServer.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: actionType,
+		Config:     &actionConfig,
+	})
+	r.NoError(err)
+
+	var progress []string
+	var diags []*tfprotov6.Diagnostic
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			progress = append(progress, e.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diags = append(diags, e.Diagnostics...)
+		}
+	}
+	return progress, diags
+}
+
+func testDynamicValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) tfprotov6.DynamicValue {
+	objectType, ok := typ.(tftypes.Object)
+	require.True(t, ok)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	value, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+	require.NoError(t, err)
+	return value
+}
+
+func TestRestartServiceAction(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002620"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Topology = "es-replica"
+	service.Nodes = 2
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// The service is restarted node by node and is ready again
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/restart", req.URL.Path)
+
+		var payload provisioning.RestartServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.True(payload.Rolling)
+
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+
+	progress, diags := invokeTestAction(t, "skysql_restart_service", map[string]tftypes.Value{
+		"service_id": tftypes.NewValue(tftypes.String, serviceID),
+		"rolling":    tftypes.NewValue(tftypes.Bool, true),
+	})
+	require.Empty(t, diags)
+	require.Equal(t, []string{"Restarting service " + serviceID}, progress)
+
+	// An invalid timeout is rejected before the service is restarted
+	_, diags = invokeTestAction(t, "skysql_restart_service", map[string]tftypes.Value{
+		"service_id": tftypes.NewValue(tftypes.String, serviceID),
+		"timeout":    tftypes.NewValue(tftypes.String, "soon"),
+	})
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Detail, `timeout must be a positive duration such as 30m or 2h, got "soon"`)
+}
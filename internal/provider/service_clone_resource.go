@@ -0,0 +1,499 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceCloneResource{}
+var _ resource.ResourceWithConfigure = &ServiceCloneResource{}
+var _ resource.ResourceWithImportState = &ServiceCloneResource{}
+
+func NewServiceCloneResource() resource.Resource {
+	return &ServiceCloneResource{}
+}
+
+// ServiceCloneResource defines the resource implementation.
+type ServiceCloneResource struct {
+	client *skysql.Client
+}
+
+// ServiceCloneResourceModel describes the resource data model.
+type ServiceCloneResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	SourceServiceID    types.String   `tfsdk:"source_service_id"`
+	PointInTime        types.String   `tfsdk:"point_in_time"`
+	Name               types.String   `tfsdk:"name"`
+	Size               types.String   `tfsdk:"size"`
+	Topology           types.String   `tfsdk:"topology"`
+	Version            types.String   `tfsdk:"version"`
+	Provider           types.String   `tfsdk:"cloud_provider"`
+	Region             types.String   `tfsdk:"region"`
+	Nodes              types.Int64    `tfsdk:"nodes"`
+	Storage            types.Int64    `tfsdk:"storage"`
+	FQDN               types.String   `tfsdk:"fqdn"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ServiceCloneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_clone"
+}
+
+func (r *ServiceCloneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new service with the data of an existing service as of a point in time. " +
+			"The clone is provisioned with the settings of the source service, unless size, topology or version are overridden, " +
+			"and the source's backups and binary logs are then replayed into it up to point_in_time. " +
+			"The clone is created in the project and availability zone of the source and gets its tags. " +
+			"A clone of a replica does not replicate from its primary, so it stays at point_in_time. " +
+			"Destroying the resource deletes the clone. " +
+			"Import a clone with an ID in the form <service_id>/<source_service_id>/<point_in_time>.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the cloned service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to clone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"point_in_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The time to restore the source data to, in RFC 3339 format. Must not be in the future",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cloned service",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 24),
+					stringvalidator.RegexMatches(
+						rxServiceName,
+						"must start from a lowercase letter and contain only lowercase letters, numbers and hyphens",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The size of the cloned service. Defaults to the size of the source service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topology": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The topology of the cloned service. Defaults to the topology of the source service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The software version of the cloned service. Defaults to the version of the source service. Must have the same major and minor version as the source",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Computed:    true,
+				Description: "The cloud provider of the cloned service, inherited from the source service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region of the cloned service, inherited from the source service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nodes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of nodes of the cloned service",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The storage size in GB of the cloned service",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The fully qualified domain name of the cloned service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to enable deletion protection. Valid values are: true or false. Default is true",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ServiceCloneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceCloneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pointInTime, err := time.Parse(time.RFC3339, data.PointInTime.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("point_in_time"), "Invalid time format", err.Error())
+		return
+	}
+	if pointInTime.After(time.Now()) {
+		resp.Diagnostics.AddAttributeError(path.Root("point_in_time"),
+			"Invalid point in time",
+			fmt.Sprintf("The point in time %q is in the future.", data.PointInTime.ValueString()))
+		return
+	}
+
+	source, err := r.client.GetServiceByID(ctx, data.SourceServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_service_id"),
+			"Unable to read source service",
+			fmt.Sprintf("Unable to read service %q: %s", data.SourceServiceID.ValueString(), err))
+		return
+	}
+
+	createServiceRequest := cloneServiceRequest(source, &data)
+
+	if serverVersionSeries(createServiceRequest.Version) != serverVersionSeries(source.Version) {
+		resp.Diagnostics.AddAttributeError(path.Root("version"),
+			"Incompatible clone version",
+			fmt.Sprintf("The source service runs server version %q and cannot be cloned into version %q. "+
+				"The clone must run the same major and minor version as the source.",
+				source.Version, createServiceRequest.Version))
+		return
+	}
+
+	service, err := r.client.CreateService(ctx, createServiceRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service clone", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created service clone resource", map[string]interface{}{
+		"id":                service.ID,
+		"source_service_id": source.ID,
+	})
+
+	serviceCloneToState(service, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = sdkresource.RetryContext(ctx, createTimeout, func() *sdkresource.RetryError {
+		svc, err := r.client.GetServiceByID(ctx, service.ID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		if svc.Status == "failed" {
+			return sdkresource.NonRetryableError(errors.New("service creation failed"))
+		}
+
+		if svc.Status != "ready" {
+			return sdkresource.RetryableError(fmt.Errorf("expected instance to be ready or failed state but was in state %s", svc.Status))
+		}
+
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service clone", fmt.Sprintf("Unable to create service, got error: %s", err))
+		return
+	}
+
+	restore, err := r.client.CreateRestore(ctx, &backup.CreateRestoreRequest{
+		ServiceID:       service.ID,
+		SourceServiceID: source.ID,
+		PointInTime:     pointInTime.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service clone",
+			fmt.Sprintf("Unable to restore service %q as of %s into service %q: %s", source.ID, data.PointInTime.ValueString(), service.ID, err))
+		return
+	}
+
+	_, err = waitForRestore(ctx, r.client, service.ID, restore.ID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service clone",
+			fmt.Sprintf("Restore of service %q as of %s did not complete: %s", source.ID, data.PointInTime.ValueString(), err))
+		return
+	}
+
+	service, err = r.client.GetServiceByID(ctx, service.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service clone", err.Error())
+		return
+	}
+
+	serviceCloneToState(service, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceCloneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service clone not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service clone", err.Error())
+		return
+	}
+
+	serviceCloneToState(service, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServiceCloneResourceModel
+	var state ServiceCloneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute that affects the clone itself forces replacement,
+	// so only the provider-side settings can change here.
+	state.DeletionProtection = plan.DeletionProtection
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ServiceCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceCloneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Can not delete service clone", "Deletion protection is enabled")
+		return
+	}
+
+	err := r.client.DeleteServiceByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service clone already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting service clone", err.Error())
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = sdkresource.RetryContext(ctx, deleteTimeout, func() *sdkresource.RetryError {
+		service, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
+		if err != nil {
+			if errors.Is(err, skysql.ErrorServiceNotFound) {
+				return nil
+			}
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected that the instance was deleted, but it was in state %s", service.Status))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting service clone", fmt.Sprintf("Unable to delete service, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted service clone resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *ServiceCloneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The source and the point in time cannot be read from the clone, so they are part of the ID.
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID",
+			fmt.Sprintf("Expected an ID in the form <service_id>/<source_service_id>/<point_in_time>, got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_service_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("point_in_time"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+}
+
+// cloneServiceRequest builds the create request for a clone from the source
+// service, applying the size, topology and version overrides from the plan.
+// A clone of a replica does not replicate, so it stays at point_in_time.
+func cloneServiceRequest(source *provisioning.Service, data *ServiceCloneResourceModel) *provisioning.CreateServiceRequest {
+	req := &provisioning.CreateServiceRequest{
+		Name:             data.Name.ValueString(),
+		ProjectID:        source.ProjectID,
+		ServiceType:      source.ServiceType,
+		Provider:         source.Provider,
+		Region:           source.Region,
+		Version:          source.Version,
+		Nodes:            uint(source.Nodes),
+		Architecture:     source.Architecture,
+		Size:             source.Size,
+		Topology:         source.Topology,
+		Storage:          uint(source.StorageVolume.Size),
+		VolumeIOPS:       uint(source.StorageVolume.IOPS),
+		VolumeThroughput: uint(source.StorageVolume.Throughput),
+		VolumeType:       source.StorageVolume.VolumeType,
+		SSLEnabled:       source.SSLEnabled,
+		NoSQLEnabled:     source.NosqlEnabled,
+		MaxscaleNodes:    source.MaxscaleNodes,
+		MaxscaleSize:     source.MaxscaleSize,
+		AvailabilityZone: source.AvailabilityZone,
+	}
+
+	// The name tag is set by SkySQL from the name of the service, so the clone gets its own.
+	for key, value := range source.Tags {
+		if key == "name" {
+			continue
+		}
+		if req.Tags == nil {
+			req.Tags = make(map[string]string, len(source.Tags))
+		}
+		req.Tags[key] = value
+	}
+
+	if len(source.Endpoints) > 0 {
+		req.Mechanism = source.Endpoints[0].Mechanism
+		req.AllowedAccounts = source.Endpoints[0].AllowedAccounts
+		req.AllowList = source.Endpoints[0].AllowList
+	}
+
+	if !data.Size.IsUnknown() && !data.Size.IsNull() {
+		req.Size = data.Size.ValueString()
+	}
+	if !data.Topology.IsUnknown() && !data.Topology.IsNull() {
+		req.Topology = data.Topology.ValueString()
+	}
+	if !data.Version.IsUnknown() && !data.Version.IsNull() {
+		req.Version = data.Version.ValueString()
+	}
+
+	return req
+}
+
+func serviceCloneToState(service *provisioning.Service, data *ServiceCloneResourceModel) {
+	data.ID = types.StringValue(service.ID)
+	data.Name = types.StringValue(service.Name)
+	data.Size = types.StringValue(service.Size)
+	data.Topology = types.StringValue(service.Topology)
+	data.Version = types.StringValue(service.Version)
+	data.Provider = types.StringValue(service.Provider)
+	data.Region = types.StringValue(service.Region)
+	data.Nodes = types.Int64Value(int64(service.Nodes))
+	data.Storage = types.Int64Value(int64(service.StorageVolume.Size))
+	data.FQDN = types.StringValue(service.FQDN)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceCloneResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const sourceID = "dbdgf42002440"
+	const cloneID = "dbdgf42002441"
+	const restoreID = "rst-clone-001"
+	const pointInTime = "2026-01-02T15:04:05Z"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	source := restoreTestService(sourceID)
+	source.Name = "production"
+	source.ProjectID = "7a6a5a2e-4d3c-4b1a-9f8e-1c2d3e4f5a6b"
+	source.AvailabilityZone = "us-central1-b"
+	source.Tags = map[string]string{"name": "production", "team": "dba"}
+	clone := restoreTestService(cloneID)
+	clone.Name = "incident-copy"
+	clone.Size = "sky-4x16"
+
+	getService := func(s *provisioning.Service, status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+s.ID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			svc := *s
+			svc.Status = status
+			json.NewEncoder(w).Encode(&svc)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Create: read the source, then provision the clone with the inherited settings
+	expectRequest(getService(source, "ready"))
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("incident-copy", payload.Name)
+		r.Equal("sky-4x16", payload.Size)
+		r.Equal(source.Topology, payload.Topology)
+		r.Equal(source.Version, payload.Version)
+		r.Equal(source.Region, payload.Region)
+		r.Equal(uint(source.StorageVolume.Size), payload.Storage)
+		r.Equal(source.ProjectID, payload.ProjectID)
+		r.Equal(source.AvailabilityZone, payload.AvailabilityZone)
+		r.Equal(map[string]string{"team": "dba"}, payload.Tags)
+
+		w.Header().Set("Content-Type", "application/json")
+		svc := *clone
+		svc.Status = "pending_create"
+		json.NewEncoder(w).Encode(&svc)
+	})
+	expectRequest(getService(clone, "ready"))
+	// Restore the source up to the point in time
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/restores", req.URL.Path)
+
+		var payload backup.CreateRestoreRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(cloneID, payload.ServiceID)
+		r.Equal(sourceID, payload.SourceServiceID)
+		r.Equal(pointInTime, payload.PointInTime)
+		r.Empty(payload.BackupID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(&backup.Restore{ID: restoreID, ServiceID: cloneID, Status: backup.StatusScheduled})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/restores/"+restoreID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&backup.Restore{ID: restoreID, ServiceID: cloneID, Status: backup.StatusSucceeded})
+	})
+	expectRequest(getService(clone, "ready"))
+	expectRequest(getService(clone, "ready"))
+	// Terraform Read after Create
+	expectRequest(getService(clone, "ready"))
+	// Import reads the clone by its ID
+	expectRequest(getService(clone, "ready"))
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+cloneID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+cloneID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service_clone" "default" {
+					source_service_id   = "` + sourceID + `"
+					point_in_time       = "` + pointInTime + `"
+					name                = "incident-copy"
+					size                = "sky-4x16"
+					deletion_protection = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service_clone.default", "id", cloneID),
+					resource.TestCheckResourceAttr("skysql_service_clone.default", "size", "sky-4x16"),
+					resource.TestCheckResourceAttr("skysql_service_clone.default", "topology", "es-single"),
+					resource.TestCheckResourceAttr("skysql_service_clone.default", "version", "10.6.11-6-1"),
+					resource.TestCheckResourceAttr("skysql_service_clone.default", "region", "us-central1"),
+				),
+			},
+			{
+				ResourceName:            "skysql_service_clone.default",
+				ImportState:             true,
+				ImportStateId:           cloneID + "/" + sourceID + "/" + pointInTime,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestServiceCloneResource_InvalidPointInTime(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, _, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	// The point in time is rejected during validation, before the provider is configured.
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service_clone" "default" {
+					source_service_id = "dbdgf42002440"
+					point_in_time     = "yesterday"
+					name              = "incident-copy"
+				}`,
+				ExpectError: regexp.MustCompile(`RFC 3339 format`),
+			},
+		},
+	})
+}
+
+func TestCloneServiceRequest_Replica(t *testing.T) {
+	r := require.New(t)
+
+	source := restoreTestService("dbdgf42002442")
+	source.ReplicationEnabled = true
+	source.PrimaryHost = "dbdgf42002440"
+
+	// The clone must not keep replicating from the primary past point_in_time
+	req := cloneServiceRequest(source, &ServiceCloneResourceModel{Name: types.StringValue("replica-copy")})
+	r.False(req.ReplicationEnabled)
+	r.Empty(req.PrimaryHost)
+	r.Nil(req.Tags)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,31 +23,35 @@ type ServiceDataSource struct {
 }
 
 type ServiceDataSourceModel struct {
-	ID                 types.String                     `tfsdk:"service_id"`
-	Name               types.String                     `tfsdk:"name"`
-	Region             types.String                     `tfsdk:"region"`
-	Provider           types.String                     `tfsdk:"cloud_provider"`
-	Tier               types.String                     `tfsdk:"tier"`
-	Topology           types.String                     `tfsdk:"topology"`
-	Version            types.String                     `tfsdk:"version"`
-	Architecture       types.String                     `tfsdk:"architecture"`
-	Size               types.String                     `tfsdk:"size"`
-	Nodes              types.Int64                      `tfsdk:"nodes"`
-	SslEnabled         types.Bool                       `tfsdk:"ssl_enabled"`
-	NosqlEnabled       types.Bool                       `tfsdk:"nosql_enabled"`
-	FQDN               types.String                     `tfsdk:"fqdn"`
-	Status             types.String                     `tfsdk:"status"`
-	CreatedOn          types.Int64                      `tfsdk:"created_on"`
-	UpdatedOn          types.Int64                      `tfsdk:"updated_on"`
-	CreatedBy          types.String                     `tfsdk:"created_by"`
-	UpdatedBy          types.String                     `tfsdk:"updated_by"`
-	Endpoints          []ServiceEndpointDataSourceModel `tfsdk:"endpoints"`
-	StorageVolume      *StorageVolumeDataSourceModel    `tfsdk:"storage_volume"`
-	OutboundIps        []types.String                   `tfsdk:"outbound_ips"`
-	IsActive           types.Bool                       `tfsdk:"is_active"`
-	ServiceType        types.String                     `tfsdk:"service_type"`
-	ReplicationEnabled types.Bool                       `tfsdk:"replication_enabled"`
-	PrimaryHost        types.String                     `tfsdk:"primary_host"`
+	ID                    types.String                      `tfsdk:"service_id"`
+	Name                  types.String                      `tfsdk:"name"`
+	Region                types.String                      `tfsdk:"region"`
+	Provider              types.String                      `tfsdk:"cloud_provider"`
+	Tier                  types.String                      `tfsdk:"tier"`
+	Topology              types.String                      `tfsdk:"topology"`
+	Version               types.String                      `tfsdk:"version"`
+	Architecture          types.String                      `tfsdk:"architecture"`
+	Size                  types.String                      `tfsdk:"size"`
+	Nodes                 types.Int64                       `tfsdk:"nodes"`
+	SslEnabled            types.Bool                        `tfsdk:"ssl_enabled"`
+	NosqlEnabled          types.Bool                        `tfsdk:"nosql_enabled"`
+	FQDN                  types.String                      `tfsdk:"fqdn"`
+	Status                types.String                      `tfsdk:"status"`
+	CreatedOn             types.Int64                       `tfsdk:"created_on"`
+	UpdatedOn             types.Int64                       `tfsdk:"updated_on"`
+	CreatedBy             types.String                      `tfsdk:"created_by"`
+	UpdatedBy             types.String                      `tfsdk:"updated_by"`
+	Endpoints             []ServiceEndpointDataSourceModel  `tfsdk:"endpoints"`
+	StorageVolume         *StorageVolumeDataSourceModel     `tfsdk:"storage_volume"`
+	OutboundIps           []types.String                    `tfsdk:"outbound_ips"`
+	IsActive              types.Bool                        `tfsdk:"is_active"`
+	ServiceType           types.String                      `tfsdk:"service_type"`
+	ReplicationEnabled    types.Bool                        `tfsdk:"replication_enabled"`
+	PrimaryHost           types.String                      `tfsdk:"primary_host"`
+	ReplicationState      types.String                      `tfsdk:"replication_state"`
+	ReplicationLagSeconds types.Int64                       `tfsdk:"replication_lag_seconds"`
+	ReplicationLastError  types.String                      `tfsdk:"replication_last_error"`
+	MaintenanceWindow     *MaintenanceWindowDataSourceModel `tfsdk:"maintenance_window"`
 }
 
 type ServiceEndpointDataSourceModel struct {
@@ -64,6 +69,13 @@ type EndpointPortDataSourceModel struct {
 	Purpose types.String `tfsdk:"purpose"`
 }
 
+type MaintenanceWindowDataSourceModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	StartHour     types.Int64  `tfsdk:"start_hour"`
+	DurationHours types.Int64  `tfsdk:"duration_hours"`
+	Timezone      types.String `tfsdk:"timezone"`
+}
+
 type StorageVolumeDataSourceModel struct {
 	Size       types.Int64  `tfsdk:"size"`
 	VolumeType types.String `tfsdk:"volume_type"`
@@ -236,6 +248,40 @@ func (d *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:    true,
 				Description: "The primary host for the service. This is only applicable for replication enabled services.",
 			},
+			"replication_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of replication from the primary. Possible values are: running, connecting, stopped or error. This is only applicable for replication enabled services.",
+			},
+			"replication_lag_seconds": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How many seconds the service lags behind its primary. This is only applicable for replication enabled services.",
+			},
+			"replication_last_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last error reported by replication, if any. This is only applicable for replication enabled services.",
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The weekly window in which SkySQL applies patches and restarts the service.",
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						Computed:    true,
+						Description: "The day the window starts on.",
+					},
+					"start_hour": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The hour the window starts at, from 0 to 23.",
+					},
+					"duration_hours": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The length of the window in hours.",
+					},
+					"timezone": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IANA time zone of start_hour. UTC when the service does not report one.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -336,6 +382,37 @@ func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.ServiceType = types.StringValue(service.ServiceType)
 	data.ReplicationEnabled = types.BoolValue(service.ReplicationEnabled)
 	data.PrimaryHost = types.StringValue(service.PrimaryHost)
+	data.ReplicationState = types.StringNull()
+	data.ReplicationLagSeconds = types.Int64Null()
+	data.ReplicationLastError = types.StringNull()
+	if service.ReplicationEnabled {
+		status, err := d.client.GetServiceReplicationStatus(ctx, service.ID)
+		if err != nil && !errors.Is(err, skysql.ErrorServiceNotFound) {
+			resp.Diagnostics.AddError("Unable to Read SkySQL service replication status", err.Error())
+			return
+		}
+		if status != nil {
+			data.ReplicationState = types.StringValue(status.State)
+			if status.LagSeconds != nil {
+				data.ReplicationLagSeconds = types.Int64Value(*status.LagSeconds)
+			}
+			if status.LastError != "" {
+				data.ReplicationLastError = types.StringValue(status.LastError)
+			}
+		}
+	}
+	if service.MaintenanceWindow != nil {
+		timezone := service.MaintenanceWindow.Timezone
+		if timezone == "" {
+			timezone = "UTC"
+		}
+		data.MaintenanceWindow = &MaintenanceWindowDataSourceModel{
+			DayOfWeek:     types.StringValue(service.MaintenanceWindow.DayOfWeek),
+			StartHour:     types.Int64Value(int64(service.MaintenanceWindow.StartHour)),
+			DurationHours: types.Int64Value(int64(service.MaintenanceWindow.DurationHours)),
+			Timezone:      types.StringValue(timezone),
+		}
+	}
 	// Set state
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
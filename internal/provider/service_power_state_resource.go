@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServicePowerStateResource{}
+var _ resource.ResourceWithConfigure = &ServicePowerStateResource{}
+var _ resource.ResourceWithImportState = &ServicePowerStateResource{}
+
+func NewServicePowerStateResource() resource.Resource {
+	return &ServicePowerStateResource{}
+}
+
+// ServicePowerStateResource defines the resource implementation.
+type ServicePowerStateResource struct {
+	client *skysql.Client
+}
+
+// ServicePowerStateResourceModel describes the resource data model.
+type ServicePowerStateResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ServiceID types.String   `tfsdk:"service_id"`
+	Active    types.Bool     `tfsdk:"active"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ServicePowerStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_power_state"
+}
+
+func (r *ServicePowerStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts or stops a service, independently of the skysql_service resource that manages it. " +
+			"Set ignore_is_active = true on that skysql_service so that it leaves the power state to this resource. " +
+			"Destroying the resource leaves the service in its current state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to start or stop",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the service is running. Changing it starts or stops the service and waits until it is ready or stopped",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ServicePowerStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServicePowerStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServicePowerStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.applyPowerState(ctx, data.ServiceID.ValueString(), data.Active.ValueBool(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting service power state",
+			fmt.Sprintf("Unable to set the power state of service %q: %s", data.ServiceID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(service.ID)
+	data.Active = types.BoolValue(service.IsActive)
+
+	tflog.Trace(ctx, "created service power state resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServicePowerStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServicePowerStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing power state from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service", err.Error())
+		return
+	}
+
+	data.ServiceID = types.StringValue(service.ID)
+	data.Active = types.BoolValue(service.IsActive)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServicePowerStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServicePowerStateResourceModel
+	var state ServicePowerStateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.applyPowerState(ctx, state.ID.ValueString(), plan.Active.ValueBool(), updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting service power state",
+			fmt.Sprintf("Unable to set the power state of service %q: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Active = types.BoolValue(service.IsActive)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ServicePowerStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServicePowerStateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The service keeps running or stays stopped; only Terraform stops managing its power state.
+	tflog.Trace(ctx, "deleted service power state resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *ServicePowerStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+// applyPowerState starts or stops a service unless it already is in the requested state,
+// and waits until it is ready or stopped.
+func (r *ServicePowerStateResource) applyPowerState(ctx context.Context, serviceID string, active bool, timeout time.Duration) (*provisioning.Service, error) {
+	service, err := r.client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if service.Topology == "serverless-standalone" {
+		return nil, errors.New("start/stop operations are not supported for serverless services")
+	}
+
+	if service.IsActive == active {
+		return service, nil
+	}
+
+	tflog.Info(ctx, "Updating service power state", map[string]interface{}{
+		"id":     serviceID,
+		"active": active,
+	})
+	if err := r.client.SetServicePowerState(ctx, serviceID, active); err != nil {
+		return nil, err
+	}
+
+	if active {
+		return waitForServiceReady(ctx, r.client, serviceID, timeout)
+	}
+	return waitForServiceStopped(ctx, r.client, serviceID, timeout)
+}
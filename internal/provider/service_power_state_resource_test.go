@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePowerStateResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002590"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	setPowerState := func(isActive bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPost, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID+"/power", req.URL.Path)
+
+			var payload provisioning.PowerStateRequest
+			r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+			r.Equal(isActive, payload.IsActive)
+
+			service.IsActive = isActive
+			service.Status = "stopped"
+			if isActive {
+				service.Status = "ready"
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the running service is stopped
+	expectRequest(getService)
+	expectRequest(setPowerState(false))
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the service is started again
+	expectRequest(getService)
+	expectRequest(setPowerState(true))
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Import reads the service by its ID
+	expectRequest(getService)
+
+	config := func(active string) string {
+		return `
+		resource "skysql_service_power_state" "this" {
+			service_id = "` + serviceID + `"
+			active     = ` + active + `
+		}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service_power_state.this", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_service_power_state.this", "active", "false"),
+				),
+			},
+			{
+				Config: config("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service_power_state.this", "active", "true"),
+				),
+			},
+			{
+				ResourceName:      "skysql_service_power_state.this",
+				ImportState:       true,
+				ImportStateId:     serviceID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestServiceResourceIgnoreIsActive_IsActiveSet(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      stoppedServiceTestConfig(true, "sky-2x8", "ignore_is_active = true"),
+				ExpectError: regexp.MustCompile(`is_active cannot be set when ignore_is_active = true`),
+			},
+		},
+	})
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,13 +23,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
 	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
 )
 
@@ -54,6 +62,13 @@ var allowListElementType = types.ObjectType{
 
 var privateConnectMechanisms = []string{"privateconnect", "privatelink"}
 
+const tierFoundation = "foundation"
+const tierPower = "power"
+
+// multiNodeTopologies are the topologies that always run more than one database node.
+// They are not available in the foundation tier.
+var multiNodeTopologies = []string{"masterslave", "es-replica", "galera", "xpand"}
+
 func NewServiceResource() resource.Resource {
 	return &ServiceResource{}
 }
@@ -65,81 +80,125 @@ type ServiceResource struct {
 
 // ServiceResourceModel describes the resource data model.
 type ServiceResourceModel struct {
-	ID                 types.String   `tfsdk:"id"`
-	Name               types.String   `tfsdk:"name"`
-	ProjectID          types.String   `tfsdk:"project_id"`
-	ServiceType        types.String   `tfsdk:"service_type"`
-	Provider           types.String   `tfsdk:"cloud_provider"`
-	Region             types.String   `tfsdk:"region"`
-	Version            types.String   `tfsdk:"version"`
-	Nodes              types.Int64    `tfsdk:"nodes"`
-	Architecture       types.String   `tfsdk:"architecture"`
-	Size               types.String   `tfsdk:"size"`
-	Topology           types.String   `tfsdk:"topology"`
-	Storage            types.Int64    `tfsdk:"storage"`
-	VolumeIOPS         types.Int64    `tfsdk:"volume_iops"`
-	VolumeThroughput   types.Int64    `tfsdk:"volume_throughput"`
-	SSLEnabled         types.Bool     `tfsdk:"ssl_enabled"`
-	NoSQLEnabled       types.Bool     `tfsdk:"nosql_enabled"`
-	VolumeType         types.String   `tfsdk:"volume_type"`
-	WaitForCreation    types.Bool     `tfsdk:"wait_for_creation"`
-	Timeouts           timeouts.Value `tfsdk:"timeouts"`
-	Mechanism          types.String   `tfsdk:"endpoint_mechanism"`
-	AllowedAccounts    types.List     `tfsdk:"endpoint_allowed_accounts"`
-	EndpointService    types.String   `tfsdk:"endpoint_service"`
-	WaitForDeletion    types.Bool     `tfsdk:"wait_for_deletion"`
-	ReplicationEnabled types.Bool     `tfsdk:"replication_enabled"`
-	PrimaryHost        types.String   `tfsdk:"primary_host"`
-	IsActive           types.Bool     `tfsdk:"is_active"`
-	WaitForUpdate      types.Bool     `tfsdk:"wait_for_update"`
-	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
-	AllowList          types.List     `tfsdk:"allow_list"`
-	MaxscaleNodes      types.Int64    `tfsdk:"maxscale_nodes"`
-	MaxscaleSize       types.String   `tfsdk:"maxscale_size"`
-	FQDN               types.String   `tfsdk:"fqdn"`
-	AvailabilityZone   types.String   `tfsdk:"availability_zone"`
-	Tags               types.Map      `tfsdk:"tags"`
-	ConfigID           types.String   `tfsdk:"config_id"`
+	ID                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	ServiceType               types.String   `tfsdk:"service_type"`
+	Provider                  types.String   `tfsdk:"cloud_provider"`
+	Region                    types.String   `tfsdk:"region"`
+	Version                   types.String   `tfsdk:"version"`
+	Nodes                     types.Int64    `tfsdk:"nodes"`
+	Architecture              types.String   `tfsdk:"architecture"`
+	Size                      types.String   `tfsdk:"size"`
+	Topology                  types.String   `tfsdk:"topology"`
+	Storage                   types.Int64    `tfsdk:"storage"`
+	VolumeIOPS                types.Int64    `tfsdk:"volume_iops"`
+	VolumeThroughput          types.Int64    `tfsdk:"volume_throughput"`
+	SSLEnabled                types.Bool     `tfsdk:"ssl_enabled"`
+	NoSQLEnabled              types.Bool     `tfsdk:"nosql_enabled"`
+	VolumeType                types.String   `tfsdk:"volume_type"`
+	WaitForCreation           types.Bool     `tfsdk:"wait_for_creation"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Mechanism                 types.String   `tfsdk:"endpoint_mechanism"`
+	AllowedAccounts           types.List     `tfsdk:"endpoint_allowed_accounts"`
+	Visibility                types.String   `tfsdk:"endpoint_visibility"`
+	EndpointService           types.String   `tfsdk:"endpoint_service"`
+	WaitForDeletion           types.Bool     `tfsdk:"wait_for_deletion"`
+	ReplicationEnabled        types.Bool     `tfsdk:"replication_enabled"`
+	PrimaryHost               types.String   `tfsdk:"primary_host"`
+	WaitForReplicationHealthy types.Bool     `tfsdk:"wait_for_replication_healthy"`
+	ReplicationState          types.String   `tfsdk:"replication_state"`
+	ReplicationLagSeconds     types.Int64    `tfsdk:"replication_lag_seconds"`
+	ReplicationLastError      types.String   `tfsdk:"replication_last_error"`
+	IsActive                  types.Bool     `tfsdk:"is_active"`
+	WaitForUpdate             types.Bool     `tfsdk:"wait_for_update"`
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
+	FinalBackup               types.Bool     `tfsdk:"final_backup"`
+	FinalBackupName           types.String   `tfsdk:"final_backup_name"`
+	FinalBackupDays           types.Int64    `tfsdk:"final_backup_retention_days"`
+	AllowList                 types.List     `tfsdk:"allow_list"`
+	Endpoints                 types.List     `tfsdk:"endpoints"`
+	MaxscaleNodes             types.Int64    `tfsdk:"maxscale_nodes"`
+	MaxscaleSize              types.String   `tfsdk:"maxscale_size"`
+	FQDN                      types.String   `tfsdk:"fqdn"`
+	ReadWritePort             types.Int64    `tfsdk:"read_write_port"`
+	ReadOnlyPort              types.Int64    `tfsdk:"read_only_port"`
+	NoSQLPort                 types.Int64    `tfsdk:"nosql_port"`
+	Ports                     types.Map      `tfsdk:"ports"`
+	ConnectionURI             types.String   `tfsdk:"connection_uri"`
+	JDBCConnectionURI         types.String   `tfsdk:"jdbc_connection_uri"`
+	AvailabilityZone          types.String   `tfsdk:"availability_zone"`
+	Tags                      types.Map      `tfsdk:"tags"`
+	ConfigID                  types.String   `tfsdk:"config_id"`
+	RestoreFrom               types.Object   `tfsdk:"restore_from"`
+	MaintenanceWindow         types.Object   `tfsdk:"maintenance_window"`
+	Encryption                types.Object   `tfsdk:"encryption"`
+	Serverless                types.Object   `tfsdk:"serverless"`
+	Tier                      types.String   `tfsdk:"tier"`
+	AutoStartForUpdates       types.Bool     `tfsdk:"auto_start_for_updates"`
+	IgnoreIsActive            types.Bool     `tfsdk:"ignore_is_active"`
 }
 
 // serviceResourceModelV1 is the model for schema version 1 (includes org_id that was removed in v2).
 type serviceResourceModelV1 struct {
-	ID                 types.String   `tfsdk:"id"`
-	Name               types.String   `tfsdk:"name"`
-	ProjectID          types.String   `tfsdk:"project_id"`
-	ServiceType        types.String   `tfsdk:"service_type"`
-	Provider           types.String   `tfsdk:"cloud_provider"`
-	Region             types.String   `tfsdk:"region"`
-	Version            types.String   `tfsdk:"version"`
-	Nodes              types.Int64    `tfsdk:"nodes"`
-	Architecture       types.String   `tfsdk:"architecture"`
-	Size               types.String   `tfsdk:"size"`
-	Topology           types.String   `tfsdk:"topology"`
-	Storage            types.Int64    `tfsdk:"storage"`
-	VolumeIOPS         types.Int64    `tfsdk:"volume_iops"`
-	VolumeThroughput   types.Int64    `tfsdk:"volume_throughput"`
-	SSLEnabled         types.Bool     `tfsdk:"ssl_enabled"`
-	NoSQLEnabled       types.Bool     `tfsdk:"nosql_enabled"`
-	VolumeType         types.String   `tfsdk:"volume_type"`
-	WaitForCreation    types.Bool     `tfsdk:"wait_for_creation"`
-	Timeouts           timeouts.Value `tfsdk:"timeouts"`
-	Mechanism          types.String   `tfsdk:"endpoint_mechanism"`
-	AllowedAccounts    types.List     `tfsdk:"endpoint_allowed_accounts"`
-	EndpointService    types.String   `tfsdk:"endpoint_service"`
-	WaitForDeletion    types.Bool     `tfsdk:"wait_for_deletion"`
-	ReplicationEnabled types.Bool     `tfsdk:"replication_enabled"`
-	PrimaryHost        types.String   `tfsdk:"primary_host"`
-	IsActive           types.Bool     `tfsdk:"is_active"`
-	WaitForUpdate      types.Bool     `tfsdk:"wait_for_update"`
-	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
-	AllowList          types.List     `tfsdk:"allow_list"`
-	MaxscaleNodes      types.Int64    `tfsdk:"maxscale_nodes"`
-	MaxscaleSize       types.String   `tfsdk:"maxscale_size"`
-	FQDN               types.String   `tfsdk:"fqdn"`
-	AvailabilityZone   types.String   `tfsdk:"availability_zone"`
-	Tags               types.Map      `tfsdk:"tags"`
-	ConfigID           types.String   `tfsdk:"config_id"`
-	OrgID              types.String   `tfsdk:"org_id"`
+	ID                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	ServiceType               types.String   `tfsdk:"service_type"`
+	Provider                  types.String   `tfsdk:"cloud_provider"`
+	Region                    types.String   `tfsdk:"region"`
+	Version                   types.String   `tfsdk:"version"`
+	Nodes                     types.Int64    `tfsdk:"nodes"`
+	Architecture              types.String   `tfsdk:"architecture"`
+	Size                      types.String   `tfsdk:"size"`
+	Topology                  types.String   `tfsdk:"topology"`
+	Storage                   types.Int64    `tfsdk:"storage"`
+	VolumeIOPS                types.Int64    `tfsdk:"volume_iops"`
+	VolumeThroughput          types.Int64    `tfsdk:"volume_throughput"`
+	SSLEnabled                types.Bool     `tfsdk:"ssl_enabled"`
+	NoSQLEnabled              types.Bool     `tfsdk:"nosql_enabled"`
+	VolumeType                types.String   `tfsdk:"volume_type"`
+	WaitForCreation           types.Bool     `tfsdk:"wait_for_creation"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Mechanism                 types.String   `tfsdk:"endpoint_mechanism"`
+	AllowedAccounts           types.List     `tfsdk:"endpoint_allowed_accounts"`
+	Visibility                types.String   `tfsdk:"endpoint_visibility"`
+	EndpointService           types.String   `tfsdk:"endpoint_service"`
+	WaitForDeletion           types.Bool     `tfsdk:"wait_for_deletion"`
+	ReplicationEnabled        types.Bool     `tfsdk:"replication_enabled"`
+	PrimaryHost               types.String   `tfsdk:"primary_host"`
+	WaitForReplicationHealthy types.Bool     `tfsdk:"wait_for_replication_healthy"`
+	ReplicationState          types.String   `tfsdk:"replication_state"`
+	ReplicationLagSeconds     types.Int64    `tfsdk:"replication_lag_seconds"`
+	ReplicationLastError      types.String   `tfsdk:"replication_last_error"`
+	IsActive                  types.Bool     `tfsdk:"is_active"`
+	WaitForUpdate             types.Bool     `tfsdk:"wait_for_update"`
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
+	FinalBackup               types.Bool     `tfsdk:"final_backup"`
+	FinalBackupName           types.String   `tfsdk:"final_backup_name"`
+	FinalBackupDays           types.Int64    `tfsdk:"final_backup_retention_days"`
+	AllowList                 types.List     `tfsdk:"allow_list"`
+	Endpoints                 types.List     `tfsdk:"endpoints"`
+	MaxscaleNodes             types.Int64    `tfsdk:"maxscale_nodes"`
+	MaxscaleSize              types.String   `tfsdk:"maxscale_size"`
+	FQDN                      types.String   `tfsdk:"fqdn"`
+	ReadWritePort             types.Int64    `tfsdk:"read_write_port"`
+	ReadOnlyPort              types.Int64    `tfsdk:"read_only_port"`
+	NoSQLPort                 types.Int64    `tfsdk:"nosql_port"`
+	Ports                     types.Map      `tfsdk:"ports"`
+	ConnectionURI             types.String   `tfsdk:"connection_uri"`
+	JDBCConnectionURI         types.String   `tfsdk:"jdbc_connection_uri"`
+	AvailabilityZone          types.String   `tfsdk:"availability_zone"`
+	Tags                      types.Map      `tfsdk:"tags"`
+	ConfigID                  types.String   `tfsdk:"config_id"`
+	RestoreFrom               types.Object   `tfsdk:"restore_from"`
+	MaintenanceWindow         types.Object   `tfsdk:"maintenance_window"`
+	Encryption                types.Object   `tfsdk:"encryption"`
+	Serverless                types.Object   `tfsdk:"serverless"`
+	Tier                      types.String   `tfsdk:"tier"`
+	AutoStartForUpdates       types.Bool     `tfsdk:"auto_start_for_updates"`
+	IgnoreIsActive            types.Bool     `tfsdk:"ignore_is_active"`
+	OrgID                     types.String   `tfsdk:"org_id"`
 }
 
 // serviceResourcePriorSchemaV1 returns the schema for version 1 (with org_id).
@@ -158,19 +217,421 @@ func serviceResourcePriorSchemaV1() *schema.Schema {
 	}
 }
 
+// ServiceRestoreFromModel is the backup a new service is seeded from.
+type ServiceRestoreFromModel struct {
+	BackupID types.String `tfsdk:"backup_id"`
+}
+
+// restoreFromBackupID returns the ID of the backup set in restore_from, or an
+// empty string when restore_from is not set or not yet known.
+func (m *ServiceResourceModel) restoreFromBackupID(ctx context.Context) (string, diag.Diagnostics) {
+	if m.RestoreFrom.IsNull() || m.RestoreFrom.IsUnknown() {
+		return "", nil
+	}
+	var restoreFrom ServiceRestoreFromModel
+	diags := m.RestoreFrom.As(ctx, &restoreFrom, basetypes.ObjectAsOptions{})
+	return restoreFrom.BackupID.ValueString(), diags
+}
+
+// ServiceMaintenanceWindowModel is the weekly window in which SkySQL patches and restarts the service.
+type ServiceMaintenanceWindowModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	StartHour     types.Int64  `tfsdk:"start_hour"`
+	DurationHours types.Int64  `tfsdk:"duration_hours"`
+	Timezone      types.String `tfsdk:"timezone"`
+}
+
+var serviceMaintenanceWindowAttrTypes = map[string]attr.Type{
+	"day_of_week":    types.StringType,
+	"start_hour":     types.Int64Type,
+	"duration_hours": types.Int64Type,
+	"timezone":       types.StringType,
+}
+
+var daysOfWeek = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// maintenanceWindow returns the maintenance window set in the model, or nil when it is not set or not yet known.
+func (m *ServiceResourceModel) maintenanceWindow(ctx context.Context) (*provisioning.MaintenanceWindow, diag.Diagnostics) {
+	if m.MaintenanceWindow.IsNull() || m.MaintenanceWindow.IsUnknown() {
+		return nil, nil
+	}
+	var window ServiceMaintenanceWindowModel
+	diags := m.MaintenanceWindow.As(ctx, &window, basetypes.ObjectAsOptions{})
+	return &provisioning.MaintenanceWindow{
+		DayOfWeek:     window.DayOfWeek.ValueString(),
+		StartHour:     int(window.StartHour.ValueInt64()),
+		DurationHours: int(window.DurationHours.ValueInt64()),
+		Timezone:      window.Timezone.ValueString(),
+	}, diags
+}
+
+func maintenanceWindowToObject(window *provisioning.MaintenanceWindow) types.Object {
+	if window == nil {
+		return types.ObjectNull(serviceMaintenanceWindowAttrTypes)
+	}
+	timezone := window.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	return types.ObjectValueMust(serviceMaintenanceWindowAttrTypes, map[string]attr.Value{
+		"day_of_week":    types.StringValue(window.DayOfWeek),
+		"start_hour":     types.Int64Value(int64(window.StartHour)),
+		"duration_hours": types.Int64Value(int64(window.DurationHours)),
+		"timezone":       types.StringValue(timezone),
+	})
+}
+
+// ServiceEncryptionModel is the customer-managed key the storage of the service is encrypted with.
+type ServiceEncryptionModel struct {
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+var serviceEncryptionAttrTypes = map[string]attr.Type{
+	"kms_key_id": types.StringType,
+}
+
+var (
+	rxAWSKMSKeyARN     = regexp.MustCompile(`^arn:aws[a-z-]*:kms:[a-z0-9-]+:[0-9]{12}:key/.+$`)
+	rxGCPCryptoKey     = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+	rxAzureKeyVaultKey = regexp.MustCompile(`^https://[a-zA-Z0-9-]+\.vault\.azure\.net/keys/[^/]+(/[0-9a-fA-F]{32})?$`)
+)
+
+// encryption returns the encryption set in the model, or nil when it is not set or not yet known.
+func (m *ServiceResourceModel) encryption(ctx context.Context) (*provisioning.Encryption, diag.Diagnostics) {
+	if m.Encryption.IsNull() || m.Encryption.IsUnknown() {
+		return nil, nil
+	}
+	var encryption ServiceEncryptionModel
+	diags := m.Encryption.As(ctx, &encryption, basetypes.ObjectAsOptions{})
+	return &provisioning.Encryption{KMSKeyID: encryption.KMSKeyID.ValueString()}, diags
+}
+
+func encryptionToObject(encryption *provisioning.Encryption) types.Object {
+	if encryption == nil || encryption.KMSKeyID == "" {
+		return types.ObjectNull(serviceEncryptionAttrTypes)
+	}
+	return types.ObjectValueMust(serviceEncryptionAttrTypes, map[string]attr.Value{
+		"kms_key_id": types.StringValue(encryption.KMSKeyID),
+	})
+}
+
+// refreshEncryption returns the encryption reported by the service, or current when it names the same key.
+// An Azure key URI without a version names the current version of the key the service reports.
+func refreshEncryption(ctx context.Context, current types.Object, encryption *provisioning.Encryption) types.Object {
+	if encryption == nil || encryption.KMSKeyID == "" || current.IsNull() || current.IsUnknown() {
+		return encryptionToObject(encryption)
+	}
+	var model ServiceEncryptionModel
+	if diags := current.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return encryptionToObject(encryption)
+	}
+	keyID := model.KMSKeyID.ValueString()
+	if keyID == encryption.KMSKeyID ||
+		(rxAzureKeyVaultKey.MatchString(keyID) && strings.HasPrefix(encryption.KMSKeyID, keyID+"/")) {
+		return current
+	}
+	return encryptionToObject(encryption)
+}
+
+// ServiceServerlessModel is the capacity settings of a serverless service.
+type ServiceServerlessModel struct {
+	MinCapacity           types.Int64 `tfsdk:"min_capacity"`
+	MaxCapacity           types.Int64 `tfsdk:"max_capacity"`
+	AutoPauseAfterMinutes types.Int64 `tfsdk:"auto_pause_after_minutes"`
+}
+
+var serviceServerlessAttrTypes = map[string]attr.Type{
+	"min_capacity":             types.Int64Type,
+	"max_capacity":             types.Int64Type,
+	"auto_pause_after_minutes": types.Int64Type,
+}
+
+// serverlessTopologies are the topologies that scale with their load instead of having a fixed size.
+var serverlessTopologies = []string{"serverless-standalone"}
+
+// nosqlTopologies are the topologies that can serve the NoSQL interface.
+var nosqlTopologies = []string{"es-single", "es-replica"}
+
+// serverless returns the serverless settings set in the model, or nil when they are not set or not yet known.
+func (m *ServiceResourceModel) serverless(ctx context.Context) (*provisioning.ServerlessSettings, diag.Diagnostics) {
+	if m.Serverless.IsNull() || m.Serverless.IsUnknown() {
+		return nil, nil
+	}
+	var serverless ServiceServerlessModel
+	diags := m.Serverless.As(ctx, &serverless, basetypes.ObjectAsOptions{})
+	settings := &provisioning.ServerlessSettings{
+		MinCapacity: int(serverless.MinCapacity.ValueInt64()),
+		MaxCapacity: int(serverless.MaxCapacity.ValueInt64()),
+	}
+	if !serverless.AutoPauseAfterMinutes.IsNull() {
+		settings.AutoPauseAfterMinutes = toPtr(int(serverless.AutoPauseAfterMinutes.ValueInt64()))
+	}
+	return settings, diags
+}
+
+func serverlessToObject(settings *provisioning.ServerlessSettings) types.Object {
+	if settings == nil {
+		return types.ObjectNull(serviceServerlessAttrTypes)
+	}
+	autoPause := types.Int64Null()
+	if settings.AutoPauseAfterMinutes != nil {
+		autoPause = types.Int64Value(int64(*settings.AutoPauseAfterMinutes))
+	}
+	return types.ObjectValueMust(serviceServerlessAttrTypes, map[string]attr.Value{
+		"min_capacity":             types.Int64Value(int64(settings.MinCapacity)),
+		"max_capacity":             types.Int64Value(int64(settings.MaxCapacity)),
+		"auto_pause_after_minutes": autoPause,
+	})
+}
+
+// validateKMSKeyID checks that keyID has the format of a key of the cloud provider.
+func validateKMSKeyID(cloudProvider string, keyID string) error {
+	switch cloudProvider {
+	case "aws":
+		if !rxAWSKMSKeyARN.MatchString(keyID) {
+			return fmt.Errorf("%q is not an AWS KMS key ARN, for example arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab. "+
+				"Aliases are not accepted because the service reports the key they point to", keyID)
+		}
+	case "gcp":
+		if !rxGCPCryptoKey.MatchString(keyID) {
+			return fmt.Errorf("%q is not a GCP Cloud KMS key name, for example projects/my-project/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key", keyID)
+		}
+	case "azure":
+		if !rxAzureKeyVaultKey.MatchString(keyID) {
+			return fmt.Errorf("%q is not an Azure Key Vault key URI, for example https://my-vault.vault.azure.net/keys/my-key", keyID)
+		}
+	}
+	return nil
+}
+
 // ServiceResourceNamedPortModel is an endpoint port
 type ServiceResourceNamedPortModel struct {
 	Name types.String `tfsdk:"name"`
 	Port types.Int64  `tfsdk:"port"`
 }
 
+// ServiceEndpointModel is one of the endpoints of a service.
+type ServiceEndpointModel struct {
+	Name            types.String `tfsdk:"name"`
+	Mechanism       types.String `tfsdk:"mechanism"`
+	Visibility      types.String `tfsdk:"visibility"`
+	AllowedAccounts types.List   `tfsdk:"allowed_accounts"`
+	AllowList       types.List   `tfsdk:"allow_list"`
+	EndpointService types.String `tfsdk:"endpoint_service"`
+}
+
+var serviceEndpointElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":             types.StringType,
+		"mechanism":        types.StringType,
+		"visibility":       types.StringType,
+		"allowed_accounts": types.ListType{ElemType: types.StringType},
+		"allow_list":       types.ListType{ElemType: allowListElementType},
+		"endpoint_service": types.StringType,
+	},
+}
+
+// defaultEndpointName is the name SkySQL gives the endpoint it creates with a service.
+const defaultEndpointName = "primary"
+
+// endpointVisibility returns the visibility an endpoint gets for its mechanism.
+func endpointVisibility(mechanism string) string {
+	if Contains[string](privateConnectMechanisms, mechanism) {
+		return visibilityPrivate
+	}
+	return visibilityPublic
+}
+
+// endpointVisibilityValue returns the visibility reported for an endpoint, which
+// defaults to the visibility of its mechanism.
+func endpointVisibilityValue(visibility string, mechanism string) types.String {
+	if visibility == "" {
+		return types.StringValue(endpointVisibility(mechanism))
+	}
+	return types.StringValue(visibility)
+}
+
+func endpointToModel(ctx context.Context, endpoint provisioning.Endpoint) (ServiceEndpointModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := ServiceEndpointModel{
+		Name:            types.StringValue(endpoint.Name),
+		Mechanism:       types.StringValue(endpoint.Mechanism),
+		Visibility:      endpointVisibilityValue(endpoint.Visibility, endpoint.Mechanism),
+		AllowedAccounts: types.ListNull(types.StringType),
+		AllowList:       types.ListNull(allowListElementType),
+		EndpointService: types.StringNull(),
+	}
+	if endpoint.EndpointService != "" {
+		model.EndpointService = types.StringValue(endpoint.EndpointService)
+	}
+	if len(endpoint.AllowedAccounts) > 0 {
+		model.AllowedAccounts, diags = types.ListValueFrom(ctx, types.StringType, endpoint.AllowedAccounts)
+	}
+	if len(endpoint.AllowList) > 0 {
+		allowList := make([]AllowListModel, 0, len(endpoint.AllowList))
+		for _, item := range endpoint.AllowList {
+			comment := types.StringNull()
+			if item.Comment != "" {
+				comment = types.StringValue(item.Comment)
+			}
+			allowList = append(allowList, AllowListModel{
+				IPAddress: types.StringValue(item.IPAddress),
+				Comment:   comment,
+			})
+		}
+		var d diag.Diagnostics
+		model.AllowList, d = types.ListValueFrom(ctx, allowListElementType, allowList)
+		diags.Append(d...)
+	}
+	return model, diags
+}
+
+// endpointsToList converts the endpoints returned by the API into the endpoints attribute.
+// Endpoints that are also in order keep their position in it, so the order the API returns
+// the endpoints in is not reported as drift.
+func endpointsToList(ctx context.Context, endpoints []provisioning.Endpoint, order types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ordered []ServiceEndpointModel
+	if !order.IsNull() && !order.IsUnknown() {
+		diags.Append(order.ElementsAs(ctx, &ordered, false)...)
+		if diags.HasError() {
+			return types.ListNull(serviceEndpointElementType), diags
+		}
+	}
+
+	sorted := make([]provisioning.Endpoint, 0, len(endpoints))
+	used := make([]bool, len(endpoints))
+	for _, want := range ordered {
+		for i, endpoint := range endpoints {
+			if !used[i] && endpoint.Name == want.Name.ValueString() {
+				sorted = append(sorted, endpoint)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, endpoint := range endpoints {
+		if !used[i] {
+			sorted = append(sorted, endpoint)
+		}
+	}
+
+	models := make([]ServiceEndpointModel, 0, len(sorted))
+	for _, endpoint := range sorted {
+		model, d := endpointToModel(ctx, endpoint)
+		diags.Append(d...)
+		models = append(models, model)
+	}
+	if diags.HasError() {
+		return types.ListNull(serviceEndpointElementType), diags
+	}
+	list, d := types.ListValueFrom(ctx, serviceEndpointElementType, models)
+	diags.Append(d...)
+	return list, diags
+}
+
+// endpointsRequest converts the endpoints attribute into the endpoints of a service update request.
+func endpointsRequest(ctx context.Context, list types.List) ([]provisioning.ServiceEndpoint, diag.Diagnostics) {
+	var models []ServiceEndpointModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	endpoints := make([]provisioning.ServiceEndpoint, 0, len(models))
+	for _, model := range models {
+		endpoint := provisioning.ServiceEndpoint{
+			Name:       model.Name.ValueString(),
+			Mechanism:  model.Mechanism.ValueString(),
+			Visibility: model.Visibility.ValueString(),
+		}
+		if model.Visibility.IsUnknown() || model.Visibility.IsNull() {
+			endpoint.Visibility = endpointVisibility(endpoint.Mechanism)
+		}
+		if !model.AllowedAccounts.IsNull() && !model.AllowedAccounts.IsUnknown() {
+			diags.Append(model.AllowedAccounts.ElementsAs(ctx, &endpoint.AllowedAccounts, false)...)
+		}
+		if !model.AllowList.IsNull() && !model.AllowList.IsUnknown() {
+			var allowList []AllowListModel
+			diags.Append(model.AllowList.ElementsAs(ctx, &allowList, false)...)
+			for _, item := range allowList {
+				endpoint.AllowList = append(endpoint.AllowList, provisioning.AllowListItem{
+					IPAddress: item.IPAddress.ValueString(),
+					Comment:   item.Comment.ValueString(),
+				})
+			}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, diags
+}
+
+// endpointsChanged reports whether the planned endpoints differ from the endpoints in state.
+// The computed endpoint_service is not compared.
+func endpointsChanged(ctx context.Context, plan types.List, state types.List) (bool, diag.Diagnostics) {
+	if plan.IsUnknown() || state.IsUnknown() || plan.IsNull() || state.IsNull() {
+		return !plan.Equal(state), nil
+	}
+	var planned, current []ServiceEndpointModel
+	diags := plan.ElementsAs(ctx, &planned, false)
+	diags.Append(state.ElementsAs(ctx, &current, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	if len(planned) != len(current) {
+		return true, diags
+	}
+	for i := range planned {
+		if !planned[i].Name.Equal(current[i].Name) ||
+			!planned[i].Mechanism.Equal(current[i].Mechanism) ||
+			!planned[i].AllowedAccounts.Equal(current[i].AllowedAccounts) ||
+			!planned[i].AllowList.Equal(current[i].AllowList) ||
+			(!planned[i].Visibility.IsUnknown() && !planned[i].Visibility.Equal(current[i].Visibility)) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// endpointsFromFlatAttributes builds the endpoints attribute from the endpoint_mechanism,
+// endpoint_allowed_accounts, endpoint_visibility, allow_list and endpoint_service attributes,
+// which describe the first endpoint of the service.
+func endpointsFromFlatAttributes(ctx context.Context, m *ServiceResourceModel) (types.List, diag.Diagnostics) {
+	if m.Mechanism.IsNull() || m.Mechanism.IsUnknown() || m.Mechanism.ValueString() == "" {
+		return types.ListNull(serviceEndpointElementType), nil
+	}
+	endpoint := provisioning.Endpoint{
+		Name:            defaultEndpointName,
+		Mechanism:       m.Mechanism.ValueString(),
+		Visibility:      m.Visibility.ValueString(),
+		EndpointService: m.EndpointService.ValueString(),
+	}
+	var diags diag.Diagnostics
+	if !m.AllowedAccounts.IsNull() && !m.AllowedAccounts.IsUnknown() {
+		diags.Append(m.AllowedAccounts.ElementsAs(ctx, &endpoint.AllowedAccounts, false)...)
+	}
+	if !m.AllowList.IsNull() && !m.AllowList.IsUnknown() {
+		var allowList []AllowListModel
+		diags.Append(m.AllowList.ElementsAs(ctx, &allowList, false)...)
+		for _, item := range allowList {
+			endpoint.AllowList = append(endpoint.AllowList, provisioning.AllowListItem{
+				IPAddress: item.IPAddress.ValueString(),
+				Comment:   item.Comment.ValueString(),
+			})
+		}
+	}
+	if diags.HasError() {
+		return types.ListNull(serviceEndpointElementType), diags
+	}
+	return endpointsToList(ctx, []provisioning.Endpoint{endpoint}, types.ListNull(serviceEndpointElementType))
+}
+
 func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_service"
 }
 
 var serviceResourceSchemaV0 = schema.Schema{
 	Description: "Creates and manages a service in SkySQL",
-	Version:     2,
+	Version:     3,
 	Attributes: map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Required: false,
@@ -262,6 +723,28 @@ var serviceResourceSchemaV0 = schema.Schema{
 				stringplanmodifier.RequiresReplace(),
 			},
 		},
+		"tier": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "The tier of the service. Valid values are: foundation or power. Defaults to the tier of the organization. " +
+				"The foundation tier is limited to single-node topologies with public endpoints. " +
+				"Moving a service to the power tier updates it in place, moving it back to the foundation tier recreates it",
+			Validators: []validator.String{
+				stringvalidator.OneOf(tierFoundation, tierPower),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplaceIf(
+					func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						if req.StateValue.ValueString() == tierPower && req.PlanValue.ValueString() == tierFoundation {
+							resp.RequiresReplace = true
+						}
+					},
+					"Moving the service back to the foundation tier requires the service to be recreated.",
+					"Moving the service back to the foundation tier requires the service to be recreated.",
+				),
+			},
+		},
 		"storage": schema.Int64Attribute{
 			Optional:    true,
 			Computed:    true,
@@ -294,10 +777,10 @@ var serviceResourceSchemaV0 = schema.Schema{
 			},
 		},
 		"nosql_enabled": schema.BoolAttribute{
-			Optional:    true,
-			Description: "Whether to enable NoSQL. Valid values are: true or false",
+			Optional: true,
+			Description: "Whether to enable the NoSQL (MongoDB-protocol) interface. Valid values are: true or false. " +
+				"Supported for the es-single and es-replica topologies. Changing it updates the service in place",
 			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.RequiresReplace(),
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
@@ -328,19 +811,34 @@ var serviceResourceSchemaV0 = schema.Schema{
 			},
 		},
 		"endpoint_mechanism": schema.StringAttribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The endpoint mechanism to use. Valid values are: privateconnect or nlb",
+			Optional:           true,
+			Computed:           true,
+			Description:        "The endpoint mechanism to use. Valid values are: privateconnect or nlb",
+			DeprecationMessage: "Use endpoints instead. endpoint_mechanism only manages the first endpoint of the service.",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"endpoint_allowed_accounts": schema.ListAttribute{
-			Optional:    true,
-			Computed:    true,
-			Description: "The list of cloud accounts (aws, azure, or gcp projects) that are allowed to access the service. Works only with `privateconnect` endpoint mechanism",
-			ElementType: types.StringType,
-			Default:     listdefault.StaticValue(types.ListNull(types.StringType)),
+			Optional:           true,
+			Computed:           true,
+			Description:        "The list of cloud accounts (aws, azure, or gcp projects) that are allowed to access the service. Works only with `privateconnect` endpoint mechanism",
+			ElementType:        types.StringType,
+			Default:            listdefault.StaticValue(types.ListNull(types.StringType)),
+			DeprecationMessage: "Use endpoints instead. endpoint_allowed_accounts only manages the first endpoint of the service.",
+		},
+		"endpoint_visibility": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "The visibility of the first endpoint of the service. Valid values are: public or private. " +
+				"Defaults to private for the privateconnect and privatelink mechanisms, and to public for nlb. " +
+				"Changing the value updates the endpoint in place. Conflicts with endpoints",
+			Validators: []validator.String{
+				stringvalidator.OneOf(visibilityPublic, visibilityPrivate),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
 		},
 		"wait_for_deletion": schema.BoolAttribute{
 			Optional:    true,
@@ -352,29 +850,59 @@ var serviceResourceSchemaV0 = schema.Schema{
 			},
 		},
 		"replication_enabled": schema.BoolAttribute{
-			Optional:    true,
-			Description: "Whether to enable global replication. Valid values are: true or false. Works for xpand-direct topology only",
+			Optional: true,
+			Description: "Whether to enable global replication. Valid values are: true or false. Works for xpand-direct topology only. " +
+				"The value the service was created with is kept, so a later promotion or switchover does not replace the service",
 			PlanModifiers: []planmodifier.Bool{
 				boolplanmodifier.RequiresReplace(),
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
 		"primary_host": schema.StringAttribute{
-			Optional:    true,
-			Description: "The primary host of the service",
+			Optional: true,
+			Description: "The primary host of the service. " +
+				"The value the service was created with is kept, so a later promotion or switchover does not replace the service",
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.RequiresReplace(),
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"wait_for_replication_healthy": schema.BoolAttribute{
+			Optional: true,
+			Description: "Whether to wait, after the service is created, until replication from the primary is running. " +
+				"Requires replication_enabled = true and wait_for_creation = true. Valid values are: true or false. Default is false",
+		},
+		"replication_state": schema.StringAttribute{
+			Computed: true,
+			Description: "The state of replication from the primary. Possible values are: running, connecting, stopped or error. " +
+				"Null when the service does not replicate from a primary",
+		},
+		"replication_lag_seconds": schema.Int64Attribute{
+			Computed:    true,
+			Description: "How many seconds the service lags behind its primary. Null when replication is not running",
+		},
+		"replication_last_error": schema.StringAttribute{
+			Computed:    true,
+			Description: "The last error reported by replication, if any",
+		},
 		"is_active": schema.BoolAttribute{
 			Optional:    true,
 			Computed:    true,
-			Description: "Whether the service is active",
+			Description: "Whether the service is active. Set it to false at creation to leave the service stopped once it is ready, which requires wait_for_creation = true",
 			PlanModifiers: []planmodifier.Bool{
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"ignore_is_active": schema.BoolAttribute{
+			Optional: true,
+			Description: "Whether the power state of the service is managed outside of this resource, for example by skysql_service_power_state. " +
+				"When true, is_active cannot be set and is only read from the service. Valid values are: true or false. Default is false",
+		},
+		"auto_start_for_updates": schema.BoolAttribute{
+			Optional: true,
+			Description: "Whether to start a stopped service to apply updates that need it running, such as size, storage and config changes. " +
+				"The service is stopped again once the updates are done. Valid values are: true or false. Default is false",
+		},
 		"wait_for_update": schema.BoolAttribute{
 			Optional:    true,
 			Computed:    true,
@@ -393,11 +921,29 @@ var serviceResourceSchemaV0 = schema.Schema{
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"final_backup": schema.BoolAttribute{
+			Optional: true,
+			Description: "Whether to take a full backup of the service before it is deleted. " +
+				"The service is only deleted once the backup has succeeded. " +
+				"The backup and the wait for the deletion each get the delete timeout, so destroying the service can take up to twice that timeout. " +
+				"The value must be applied before the service is destroyed. Valid values are: true or false. Default is false",
+		},
+		"final_backup_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the final backup. Requires final_backup = true",
+		},
+		"final_backup_retention_days": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The number of days to keep the final backup. Requires final_backup = true. Defaults to the retention of the backup schedule",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
 		"allow_list": schema.ListNestedAttribute{
 			Required:    false,
 			Computed:    true,
 			Optional:    true,
-			Description: "The list of IP addresses with comments to allow access to the service",
+			Description: "The list of IP addresses with comments to allow access to the first endpoint of the service. Conflicts with endpoints",
 			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
 					"ip": schema.StringAttribute{
@@ -448,12 +994,111 @@ var serviceResourceSchemaV0 = schema.Schema{
 			},
 			Description: "The fully qualified domain name of the service. The FQDN is only available when the service is in the ready state",
 		},
+		"read_write_port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The read-write port of the first endpoint of the service",
+		},
+		"read_only_port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The read-only port of the first endpoint of the service. Null when the topology has no read-only port",
+		},
+		"nosql_port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The NoSQL port of the first endpoint of the service. Null when NoSQL is not enabled",
+		},
+		"ports": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: "The ports of the first endpoint of the service, keyed by port name",
+		},
+		"connection_uri": schema.StringAttribute{
+			Computed: true,
+			Description: "A mysql:// URI for the read-write port of the service, without credentials. " +
+				"When ssl_enabled is true the URI sets ssl-mode=VERIFY_IDENTITY, so the client verifies the server certificate and host name. " +
+				"The FQDN is only available when the service is in the ready state",
+		},
+		"jdbc_connection_uri": schema.StringAttribute{
+			Computed: true,
+			Description: "A JDBC URL for the read-write port of the service, for the MariaDB Connector/J, without credentials. " +
+				"When ssl_enabled is true the URL sets sslMode=verify-full, so the client verifies the server certificate and host name. " +
+				"The FQDN is only available when the service is in the ready state",
+		},
 		"endpoint_service": schema.StringAttribute{
 			Required:    false,
 			Optional:    false,
 			Computed:    true,
 			Description: "The endpoint service name of the service, when mechanism is a privateconnect.",
 		},
+		"endpoints": schema.ListNestedAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "The endpoints of the service. Each endpoint has its own mechanism, visibility, allowed accounts and allow list, " +
+				"and endpoints are added, changed or removed in place. Conflicts with endpoint_mechanism, endpoint_allowed_accounts and allow_list. " +
+				"When not set, the endpoints SkySQL created for the service are reported",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the endpoint, for example primary",
+					},
+					"mechanism": schema.StringAttribute{
+						Required:    true,
+						Description: "The endpoint mechanism to use. Valid values are: privateconnect, privatelink or nlb",
+						Validators: []validator.String{
+							stringvalidator.OneOf("nlb", "privateconnect", "privatelink"),
+						},
+					},
+					"visibility": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "The visibility of the endpoint. Valid values are: public or private. Defaults to private for privateconnect and privatelink, and to public for nlb",
+						Validators: []validator.String{
+							stringvalidator.OneOf(visibilityPublic, visibilityPrivate),
+						},
+					},
+					"allowed_accounts": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The list of cloud accounts (aws, azure, or gcp projects) that are allowed to access the endpoint. Works only with the privateconnect and privatelink mechanisms",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"allow_list": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "The list of IP addresses with comments to allow access to the endpoint. Works only with the nlb mechanism",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"ip": schema.StringAttribute{
+									Required:    true,
+									Description: "The IP address to allow access to the endpoint. The IP must be in a valid CIDR format",
+									Validators: []validator.String{
+										allowListIPValidator{},
+									},
+								},
+								"comment": schema.StringAttribute{
+									Optional:    true,
+									Description: "A comment to describe the IP address",
+								},
+							},
+						},
+					},
+					"endpoint_service": schema.StringAttribute{
+						Computed:    true,
+						Description: "The endpoint service name of the endpoint, when mechanism is privateconnect or privatelink",
+					},
+				},
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"availability_zone": schema.StringAttribute{
 			Required:    false,
 			Optional:    true,
@@ -483,6 +1128,102 @@ var serviceResourceSchemaV0 = schema.Schema{
 				"- **Remove** `config_id` → reverts the service to its default configuration via `DELETE /services/{id}/config`.\n" +
 				"- If the service already has the specified config applied (e.g. after import), the operation is a no-op.",
 		},
+		"maintenance_window": schema.SingleNestedAttribute{
+			Optional: true,
+			Description: "The weekly window in which SkySQL applies patches and restarts the service. " +
+				"Once set or imported, changes made outside of Terraform are reported as drift. " +
+				"Removing this attribute reverts the service to the default maintenance window.",
+			Attributes: map[string]schema.Attribute{
+				"day_of_week": schema.StringAttribute{
+					Required:    true,
+					Description: "The day the window starts on. Valid values are: monday, tuesday, wednesday, thursday, friday, saturday or sunday",
+					Validators: []validator.String{
+						stringvalidator.OneOf(daysOfWeek...),
+					},
+				},
+				"start_hour": schema.Int64Attribute{
+					Required:    true,
+					Description: "The hour the window starts at, from 0 to 23",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 23),
+					},
+				},
+				"duration_hours": schema.Int64Attribute{
+					Required:    true,
+					Description: "The length of the window in hours, from 1 to 24",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 24),
+					},
+				},
+				"timezone": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("UTC"),
+					Description: "The IANA time zone of start_hour, for example Europe/Berlin. Default is UTC",
+				},
+			},
+		},
+		"restore_from": schema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Seed the new service with the data of an existing backup. " +
+				"The service is provisioned first and the backup is then restored into it. " +
+				"The backup must have succeeded and must come from a service with the same topology and the same major and minor server version. " +
+				"Requires wait_for_creation = true. Changing this value forces a new service to be created.",
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplace(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"backup_id": schema.StringAttribute{
+					Required:    true,
+					Description: "The ID of the backup to restore",
+				},
+			},
+		},
+		"encryption": schema.SingleNestedAttribute{
+			Optional: true,
+			Description: "Encrypt the storage of the service with a customer-managed key. " +
+				"The key is checked against the format of the cloud provider at plan time. " +
+				"Once set or imported, the key in use is read back from the service, so a key changed outside of Terraform is reported as drift. " +
+				"Changing this value forces a new service to be created.",
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplace(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"kms_key_id": schema.StringAttribute{
+					Required: true,
+					Description: "The key to encrypt the storage with: an AWS KMS key ARN, a GCP Cloud KMS key name " +
+						"(projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>) or an Azure Key Vault key URI",
+				},
+			},
+		},
+		"serverless": schema.SingleNestedAttribute{
+			Optional: true,
+			Description: "The capacity settings of a serverless-standalone service. Valid only for serverless topologies. " +
+				"Changes are applied in place. Removing this attribute reverts the service to the default capacity settings.",
+			Attributes: map[string]schema.Attribute{
+				"min_capacity": schema.Int64Attribute{
+					Required:    true,
+					Description: "The capacity units the service keeps while it is running, from 1 to 64",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 64),
+					},
+				},
+				"max_capacity": schema.Int64Attribute{
+					Required:    true,
+					Description: "The capacity units the service can scale up to, from 1 to 64. Must not be lower than min_capacity",
+					Validators: []validator.Int64{
+						int64validator.Between(1, 64),
+					},
+				},
+				"auto_pause_after_minutes": schema.Int64Attribute{
+					Optional:    true,
+					Description: "Pause the service after it has been idle for this many minutes, from 5 to 1440. When omitted, the service is never paused",
+					Validators: []validator.Int64{
+						int64validator.Between(5, 1440),
+					},
+				},
+			},
+		},
 	},
 	Blocks: map[string]schema.Block{
 		"timeouts": timeouts.Block(context.Background(), timeouts.Opts{
@@ -536,24 +1277,69 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	createServiceRequest := &provisioning.CreateServiceRequest{
-		Name:               state.Name.ValueString(),
-		ProjectID:          state.ProjectID.ValueString(),
-		ServiceType:        state.ServiceType.ValueString(),
-		Provider:           state.Provider.ValueString(),
-		Region:             state.Region.ValueString(),
-		Version:            state.Version.ValueString(),
-		Nodes:              uint(state.Nodes.ValueInt64()),
-		Architecture:       state.Architecture.ValueString(),
-		Size:               state.Size.ValueString(),
-		Topology:           state.Topology.ValueString(),
-		Storage:            uint(state.Storage.ValueInt64()),
+	// Validate: is_active = false requires wait_for_creation to be true.
+	if !state.IsActive.IsUnknown() && !state.IsActive.IsNull() && !state.IsActive.ValueBool() && !state.WaitForCreation.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"is_active = false requires wait_for_creation = true. The service must be ready before it can be stopped.",
+		)
+		return
+	}
+
+	restoreBackupID, diags := state.restoreFromBackupID(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate: restore_from requires wait_for_creation to be true.
+	if restoreBackupID != "" && !state.WaitForCreation.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"restore_from requires wait_for_creation = true. The service must be ready before a backup can be restored into it.",
+		)
+		return
+	}
+
+	// Validate: endpoints requires wait_for_creation to be true.
+	endpointsConfigured := !state.Endpoints.IsNull() && !state.Endpoints.IsUnknown()
+	if endpointsConfigured && !state.WaitForCreation.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"endpoints requires wait_for_creation = true. The service must be ready before its endpoints can be configured.",
+		)
+		return
+	}
+
+	// Validate: wait_for_replication_healthy requires a replica that is waited for.
+	if state.WaitForReplicationHealthy.ValueBool() && (!state.ReplicationEnabled.ValueBool() || !state.WaitForCreation.ValueBool()) {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			"wait_for_replication_healthy requires replication_enabled = true and wait_for_creation = true.",
+		)
+		return
+	}
+
+	createServiceRequest := &provisioning.CreateServiceRequest{
+		Name:               state.Name.ValueString(),
+		ProjectID:          state.ProjectID.ValueString(),
+		ServiceType:        state.ServiceType.ValueString(),
+		Provider:           state.Provider.ValueString(),
+		Region:             state.Region.ValueString(),
+		Version:            state.Version.ValueString(),
+		Nodes:              uint(state.Nodes.ValueInt64()),
+		Architecture:       state.Architecture.ValueString(),
+		Size:               state.Size.ValueString(),
+		Topology:           state.Topology.ValueString(),
+		Tier:               state.Tier.ValueString(),
+		Storage:            uint(state.Storage.ValueInt64()),
 		VolumeIOPS:         uint(state.VolumeIOPS.ValueInt64()),
 		VolumeThroughput:   uint(state.VolumeThroughput.ValueInt64()),
 		SSLEnabled:         state.SSLEnabled.ValueBool(),
 		NoSQLEnabled:       state.NoSQLEnabled.ValueBool(),
 		VolumeType:         state.VolumeType.ValueString(),
 		Mechanism:          state.Mechanism.ValueString(),
+		Visibility:         state.Visibility.ValueString(),
 		ReplicationEnabled: state.ReplicationEnabled.ValueBool(),
 		PrimaryHost:        state.PrimaryHost.ValueString(),
 		MaxscaleNodes:      uint(state.MaxscaleNodes.ValueInt64()),
@@ -571,6 +1357,24 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		createServiceRequest.Tags = tags
 	}
 
+	createServiceRequest.MaintenanceWindow, diags = state.maintenanceWindow(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createServiceRequest.Encryption, diags = state.encryption(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createServiceRequest.Serverless, diags = state.serverless(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !Contains[string]([]string{"gcp", "aws", "azure"}, createServiceRequest.Provider) {
 		resp.Diagnostics.AddAttributeError(path.Root("provider"),
 			"Invalid provider value",
@@ -614,6 +1418,19 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	// The service is created with its first endpoint; the other endpoints are added once it is ready.
+	if endpointsConfigured {
+		endpoints, diags := endpointsRequest(ctx, state.Endpoints)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		createServiceRequest.Mechanism = endpoints[0].Mechanism
+		createServiceRequest.Visibility = endpoints[0].Visibility
+		createServiceRequest.AllowedAccounts = endpoints[0].AllowedAccounts
+		createServiceRequest.AllowList = endpoints[0].AllowList
+	}
+
 	// The topologies endpoint is organization-aware: topologies the calling
 	// organization cannot launch (e.g. serverless for BYOA) are absent from it.
 	if createServiceRequest.Topology == "serverless-standalone" {
@@ -674,12 +1491,22 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 	state.Storage = types.Int64Value(int64(service.StorageVolume.Size))
 	state.SSLEnabled = types.BoolValue(service.SSLEnabled)
 	state.AvailabilityZone = types.StringValue(service.AvailabilityZone)
+	// An unconfigured tier is unknown until the API reports the default tier of the organization.
+	if state.Tier.IsUnknown() || service.Tier != "" {
+		state.Tier = stringValueOrNull(service.Tier)
+	}
+	// Replication only starts once the service is ready.
+	replicationStatusToState(nil, state)
+	connectionDetailsToState(service, state)
 	if len(service.Endpoints) > 0 {
 		state.Mechanism = types.StringValue(service.Endpoints[0].Mechanism)
+		state.Visibility = endpointVisibilityValue(service.Endpoints[0].Visibility, service.Endpoints[0].Mechanism)
 		r.setAllowAccounts(ctx, state, service.Endpoints[0].AllowedAccounts)
 		state.AllowList, _ = r.allowListToListType(ctx, service.Endpoints[0].AllowList)
 		state.EndpointService = types.StringValue(service.Endpoints[0].EndpointService)
 	}
+	state.Endpoints, diags = endpointsToList(ctx, service.Endpoints, state.Endpoints)
+	resp.Diagnostics.Append(diags...)
 	if !(state.MaxscaleSize.IsUnknown() || state.MaxscaleSize.IsNull()) && service.MaxscaleSize != nil {
 		state.MaxscaleSize = types.StringValue(*service.MaxscaleSize)
 	}
@@ -727,10 +1554,48 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		r.readServiceState(ctx, state)
+		r.readServiceState(ctx, state, &resp.Diagnostics)
 		r.updateAllowedAccountsState(plan, state)
 		r.updateAllowListState(plan, state)
 
+		if endpointsConfigured {
+			changed, diags := endpointsChanged(ctx, plan.Endpoints, state.Endpoints)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if changed {
+				r.createServiceEndpoints(ctx, plan, state, createTimeout, resp)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+
+		// Restore the backup after the service is ready and before any config is applied.
+		if restoreBackupID != "" {
+			tflog.Info(ctx, "Restoring backup into service", map[string]interface{}{
+				"service_id": service.ID,
+				"backup_id":  restoreBackupID,
+			})
+			restore, err := r.client.CreateRestore(ctx, &backup.CreateRestoreRequest{
+				ServiceID: service.ID,
+				BackupID:  restoreBackupID,
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Error restoring backup into service",
+					fmt.Sprintf("Unable to restore backup %q into service %q: %s", restoreBackupID, service.ID, err.Error()))
+				return
+			}
+
+			_, err = waitForRestore(ctx, r.client, service.ID, restore.ID, createTimeout)
+			if err != nil {
+				resp.Diagnostics.AddError("Error restoring backup into service",
+					fmt.Sprintf("Restore of backup %q into service %q did not complete: %s", restoreBackupID, service.ID, err))
+				return
+			}
+		}
+
 		// Apply config after service is ready.
 		if !plan.ConfigID.IsNull() && !plan.ConfigID.IsUnknown() && plan.ConfigID.ValueString() != "" {
 			configID := plan.ConfigID.ValueString()
@@ -764,10 +1629,79 @@ func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 		}
 
+		if state.WaitForReplicationHealthy.ValueBool() {
+			status, err := waitForReplicationHealthy(ctx, r.client, service.ID, createTimeout)
+			if status != nil {
+				replicationStatusToState(status, state)
+			}
+			if err != nil {
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+				resp.Diagnostics.AddError("Error creating service",
+					fmt.Sprintf("Replication of service %q did not become healthy: %s", service.ID, err))
+				return
+			}
+		}
+
+		// Stop the service last, once everything that needs it running is done.
+		if !plan.IsActive.IsUnknown() && !plan.IsActive.IsNull() && !plan.IsActive.ValueBool() {
+			tflog.Info(ctx, "Stopping service after creation", map[string]interface{}{
+				"id": service.ID,
+			})
+			err = r.client.SetServicePowerState(ctx, service.ID, false)
+			if err != nil {
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+				resp.Diagnostics.AddError("Error stopping service",
+					fmt.Sprintf("Unable to stop service %q after creation: %s", service.ID, err))
+				return
+			}
+
+			_, err = waitForServiceStopped(ctx, r.client, service.ID, createTimeout)
+			if err != nil {
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+				resp.Diagnostics.AddError("Error stopping service",
+					fmt.Sprintf("Service %q did not stop after creation: %s", service.ID, err))
+				return
+			}
+			state.IsActive = plan.IsActive
+		}
+
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	}
 }
 
+// createServiceEndpoints replaces the endpoint a new service was created with by the planned endpoints.
+func (r *ServiceResource) createServiceEndpoints(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, timeout time.Duration, resp *resource.CreateResponse) {
+	endpoints, diags := endpointsRequest(ctx, plan.Endpoints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Configuring service endpoints", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+	_, err := r.client.ModifyServiceEndpoints(ctx, state.ID.ValueString(), endpoints)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring service endpoints", err.Error())
+		return
+	}
+
+	_, err = waitForServiceReady(ctx, r.client, state.ID.ValueString(), timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring service endpoints",
+			fmt.Sprintf("Service did not return to ready state after its endpoints were configured: %s", err))
+		return
+	}
+
+	state.Endpoints = plan.Endpoints
+	if err := r.readServiceState(ctx, state, &resp.Diagnostics); err != nil {
+		resp.Diagnostics.AddError("Can not read service", err.Error())
+		return
+	}
+	r.updateAllowedAccountsState(plan, state)
+	r.updateAllowListState(plan, state)
+}
+
 func (r *ServiceResource) setAllowAccounts(ctx context.Context, data *ServiceResourceModel, allowedAccounts []string) {
 	data.AllowedAccounts, _ = types.ListValueFrom(ctx, types.StringType, allowedAccounts)
 }
@@ -801,7 +1735,7 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	err := r.readServiceState(ctx, state)
+	err := r.readServiceState(ctx, state, &resp.Diagnostics)
 	if err != nil {
 		if errors.Is(err, skysql.ErrorServiceNotFound) {
 			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
@@ -826,13 +1760,16 @@ func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 }
 
-func (r *ServiceResource) readServiceState(ctx context.Context, data *ServiceResourceModel) error {
+// readServiceState refreshes data from the service. Diagnostics of converting the service into
+// attribute values are appended to diags.
+func (r *ServiceResource) readServiceState(ctx context.Context, data *ServiceResourceModel, diags *diag.Diagnostics) error {
 	service, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
 	if err != nil {
 		return err
 	}
 	data.ID = types.StringValue(service.ID)
 	data.FQDN = types.StringValue(service.FQDN)
+	connectionDetailsToState(service, data)
 	data.Name = types.StringValue(service.Name)
 	data.SSLEnabled = types.BoolValue(service.SSLEnabled)
 	data.ServiceType = types.StringValue(service.ServiceType)
@@ -856,20 +1793,20 @@ func (r *ServiceResource) readServiceState(ctx context.Context, data *ServiceRes
 		data.VolumeThroughput = types.Int64Null()
 	}
 	data.VolumeType = types.StringValue(service.StorageVolume.VolumeType)
-	if !data.ReplicationEnabled.IsNull() {
-		data.ReplicationEnabled = types.BoolValue(service.ReplicationEnabled)
-	}
-	if !data.PrimaryHost.IsNull() {
-		data.PrimaryHost = types.StringValue(service.PrimaryHost)
-	}
+	// replication_enabled and primary_host keep the values the service was created with. A promotion
+	// or switchover changes them on the API, which must not replace the promoted service.
 	data.IsActive = types.BoolValue(service.IsActive)
 	data.SSLEnabled = types.BoolValue(service.SSLEnabled)
 	if len(service.Endpoints) > 0 {
 		data.Mechanism = types.StringValue(service.Endpoints[0].Mechanism)
+		data.Visibility = endpointVisibilityValue(service.Endpoints[0].Visibility, service.Endpoints[0].Mechanism)
 		r.setAllowAccounts(ctx, data, service.Endpoints[0].AllowedAccounts)
 		data.AllowList, _ = r.allowListToListType(ctx, service.Endpoints[0].AllowList)
 		data.EndpointService = types.StringValue(service.Endpoints[0].EndpointService)
 	}
+	var endpointDiags diag.Diagnostics
+	data.Endpoints, endpointDiags = endpointsToList(ctx, service.Endpoints, data.Endpoints)
+	diags.Append(endpointDiags...)
 	if !(data.MaxscaleSize.IsUnknown() || data.MaxscaleSize.IsNull()) && service.MaxscaleSize != nil {
 		data.MaxscaleSize = types.StringValue(*service.MaxscaleSize)
 	} else {
@@ -896,9 +1833,131 @@ func (r *ServiceResource) readServiceState(ctx context.Context, data *ServiceRes
 		}
 	}
 	// If data.Tags is null (user didn't specify tags), leave it null — don't populate from API.
+	// The maintenance window is only tracked once it is managed by Terraform or imported,
+	// as the API may report a default window for services that never configured one.
+	if !data.MaintenanceWindow.IsNull() && !data.MaintenanceWindow.IsUnknown() {
+		data.MaintenanceWindow = maintenanceWindowToObject(service.MaintenanceWindow)
+	}
+	// nosql_enabled is only tracked once it is managed by Terraform.
+	if !data.NoSQLEnabled.IsNull() && !data.NoSQLEnabled.IsUnknown() {
+		data.NoSQLEnabled = types.BoolValue(service.NosqlEnabled)
+	}
+	data.Tier = stringValueOrNull(service.Tier)
+	// encryption is only tracked once it is managed by Terraform or the service is imported,
+	// so the default key of a service created without one is not reported as drift.
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
+		data.Encryption = refreshEncryption(ctx, data.Encryption, service.Encryption)
+	}
+	// The serverless settings are only tracked once they are managed by Terraform.
+	if !data.Serverless.IsNull() && !data.Serverless.IsUnknown() {
+		data.Serverless = serverlessToObject(service.Serverless)
+	}
+	return r.readReplicationStatus(ctx, service, data)
+}
+
+// readReplicationStatus refreshes the replication health of a replica service.
+// Services that do not replicate from a primary have no replication status.
+func (r *ServiceResource) readReplicationStatus(ctx context.Context, service *provisioning.Service, data *ServiceResourceModel) error {
+	if !service.ReplicationEnabled {
+		replicationStatusToState(nil, data)
+		return nil
+	}
+
+	status, err := r.client.GetServiceReplicationStatus(ctx, service.ID)
+	if err != nil {
+		// The status is reported once replication has been set up. Its absence
+		// must not be mistaken for the service being gone.
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			replicationStatusToState(nil, data)
+			return nil
+		}
+		return err
+	}
+	replicationStatusToState(status, data)
 	return nil
 }
 
+func replicationStatusToState(status *provisioning.ReplicationStatus, data *ServiceResourceModel) {
+	if status == nil {
+		data.ReplicationState = types.StringNull()
+		data.ReplicationLagSeconds = types.Int64Null()
+		data.ReplicationLastError = types.StringNull()
+		return
+	}
+
+	data.ReplicationState = types.StringValue(status.State)
+	if status.LagSeconds != nil {
+		data.ReplicationLagSeconds = types.Int64Value(*status.LagSeconds)
+	} else {
+		data.ReplicationLagSeconds = types.Int64Null()
+	}
+	if status.LastError != "" {
+		data.ReplicationLastError = types.StringValue(status.LastError)
+	} else {
+		data.ReplicationLastError = types.StringNull()
+	}
+}
+
+// connectionDetailsToState sets the ports and connection URIs of the first endpoint of the service.
+func connectionDetailsToState(service *provisioning.Service, data *ServiceResourceModel) {
+	data.ReadWritePort = types.Int64Null()
+	data.ReadOnlyPort = types.Int64Null()
+	data.NoSQLPort = types.Int64Null()
+	data.ConnectionURI = types.StringNull()
+	data.JDBCConnectionURI = types.StringNull()
+
+	ports := make(map[string]attr.Value)
+	if len(service.Endpoints) > 0 {
+		for _, port := range service.Endpoints[0].Ports {
+			ports[port.Name] = types.Int64Value(int64(port.Port))
+			switch port.Purpose {
+			case provisioning.PortPurposeReadWrite:
+				data.ReadWritePort = types.Int64Value(int64(port.Port))
+			case provisioning.PortPurposeReadOnly:
+				data.ReadOnlyPort = types.Int64Value(int64(port.Port))
+			case provisioning.PortPurposeNoSQL:
+				data.NoSQLPort = types.Int64Value(int64(port.Port))
+			}
+		}
+	}
+	data.Ports = types.MapValueMust(types.Int64Type, ports)
+
+	if service.FQDN == "" || data.ReadWritePort.IsNull() {
+		return
+	}
+	address := fmt.Sprintf("%s:%d", service.FQDN, data.ReadWritePort.ValueInt64())
+	if service.SSLEnabled {
+		data.ConnectionURI = types.StringValue("mysql://" + address + "?ssl-mode=VERIFY_IDENTITY")
+		data.JDBCConnectionURI = types.StringValue("jdbc:mariadb://" + address + "/?sslMode=verify-full")
+	} else {
+		data.ConnectionURI = types.StringValue("mysql://" + address)
+		data.JDBCConnectionURI = types.StringValue("jdbc:mariadb://" + address + "/")
+	}
+}
+
+// waitForReplicationHealthy polls the replication status of a replica service until replication is running.
+func waitForReplicationHealthy(ctx context.Context, client *skysql.Client, serviceID string, timeout time.Duration) (*provisioning.ReplicationStatus, error) {
+	var result *provisioning.ReplicationStatus
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		status, err := client.GetServiceReplicationStatus(ctx, serviceID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving replication status: %v", err))
+		}
+
+		result = status
+		switch status.State {
+		case provisioning.ReplicationStateRunning:
+			return nil
+		case provisioning.ReplicationStateError:
+			return sdkresource.NonRetryableError(fmt.Errorf("replication failed: %s", status.LastError))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected replication to be running but was %s", status.State))
+	})
+
+	return result, err
+}
+
 func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan *ServiceResourceModel
 	var state *ServiceResourceModel
@@ -922,18 +1981,88 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 	state.WaitForDeletion = plan.WaitForDeletion
 	state.Timeouts = plan.Timeouts
 	state.DeletionProtection = plan.DeletionProtection
+	state.FinalBackup = plan.FinalBackup
+	state.FinalBackupName = plan.FinalBackupName
+	state.FinalBackupDays = plan.FinalBackupDays
+	state.WaitForReplicationHealthy = plan.WaitForReplicationHealthy
+	state.AutoStartForUpdates = plan.AutoStartForUpdates
+	state.IgnoreIsActive = plan.IgnoreIsActive
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.updateServicePowerState(ctx, plan, state, resp)
+	if !plan.IgnoreIsActive.ValueBool() {
+		r.updateServicePowerState(ctx, plan, state, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	started := false
+	if plan.AutoStartForUpdates.ValueBool() && !state.IsActive.ValueBool() && serviceUpdateChangesService(req) {
+		r.startServiceForUpdates(ctx, state, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		started = true
+	}
+
+	r.updateService(ctx, plan, state, resp)
+
+	// The service is stopped again even when one of the updates failed.
+	if started && !resp.State.Raw.IsNull() {
+		r.stopServiceAfterUpdates(ctx, state, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Endpoints.IsUnknown() {
+		state.Endpoints = plan.Endpoints
+	}
+	err := r.readServiceState(ctx, state, &resp.Diagnostics)
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+		resp.Diagnostics.AddError("Can not read service", err.Error())
+		return
+	}
+
+	r.updateAllowedAccountsState(plan, state)
+	r.updateAllowListState(plan, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateService applies the planned changes of a service, stopping at the first one that fails.
+func (r *ServiceResource) updateService(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	// The tier is changed first so that features of the new tier can be used by the other updates.
+	r.updateServiceTier(ctx, plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateServiceNoSQL(ctx, plan, state, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.updateServiceEndpoints(ctx, plan, state, resp)
+	// endpoints is only unknown in the plan when the flat endpoint attributes change.
+	if plan.Endpoints.IsUnknown() {
+		r.updateServiceEndpoints(ctx, plan, state, resp)
+	} else {
+		r.updateServiceEndpointList(ctx, plan, state, resp)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -968,47 +2097,148 @@ func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	err := r.readServiceState(ctx, state)
-	if err != nil {
-		if errors.Is(err, skysql.ErrorServiceNotFound) {
-			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
-				"id": state.ID.ValueString(),
-			})
-			resp.State.RemoveResource(ctx)
+	r.updateServiceMaintenanceWindow(ctx, plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			return
+	r.updateServiceServerless(ctx, plan, state, resp)
+}
+
+// providerOnlyAttributes are attributes that only change how the provider manages a service.
+// Changing them alone does not call the API.
+var providerOnlyAttributes = []string{
+	"wait_for_creation",
+	"wait_for_deletion",
+	"wait_for_update",
+	"wait_for_replication_healthy",
+	"timeouts",
+	"deletion_protection",
+	"final_backup",
+	"final_backup_name",
+	"final_backup_retention_days",
+	"auto_start_for_updates",
+	"ignore_is_active",
+}
+
+// serviceUpdateChangesService reports whether an update changes anything but is_active and the
+// attributes that are either managed by the provider only or refreshed after the update.
+func serviceUpdateChangesService(req resource.UpdateRequest) bool {
+	diffs, err := req.Plan.Raw.Diff(req.State.Raw)
+	if err != nil {
+		return true
+	}
+	for _, d := range diffs {
+		steps := d.Path.Steps()
+		if len(steps) == 0 {
+			continue
+		}
+		name, ok := steps[0].(tftypes.AttributeName)
+		if !ok {
+			return true
+		}
+		if string(name) != "is_active" &&
+			!Contains[string](providerOnlyAttributes, string(name)) &&
+			!Contains[string](refreshedAttributes, string(name)) {
+			return true
 		}
-		resp.Diagnostics.AddError("Can not read service", err.Error())
-		return
 	}
+	return false
+}
 
-	r.updateAllowedAccountsState(plan, state)
-	r.updateAllowListState(plan, state)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+// startServiceForUpdates starts a stopped service and waits until it is ready to be updated.
+func (r *ServiceResource) startServiceForUpdates(ctx context.Context, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	serviceID := state.ID.ValueString()
+	timeout, diags := state.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-}
 
-func (r *ServiceResource) updateAllowedAccountsState(plan *ServiceResourceModel, state *ServiceResourceModel) {
-	if plan.AllowedAccounts.IsNull() && len(state.AllowedAccounts.Elements()) == 0 {
-		state.AllowedAccounts = plan.AllowedAccounts
+	tflog.Info(ctx, "Starting stopped service to apply updates", map[string]interface{}{
+		"id": serviceID,
+	})
+	err := r.client.SetServicePowerState(ctx, serviceID, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting service for updates",
+			fmt.Sprintf("Unable to start service %q to apply updates: %s", serviceID, err))
+		return
 	}
-	if len(plan.AllowedAccounts.Elements()) == 0 && state.AllowedAccounts.IsNull() {
-		state.AllowedAccounts = plan.AllowedAccounts
+
+	_, err = waitForServiceReady(ctx, r.client, serviceID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting service for updates",
+			fmt.Sprintf("Service %q did not become ready to apply updates: %s", serviceID, err))
+		return
 	}
+
+	state.IsActive = types.BoolValue(true)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Service started, applying updates", map[string]interface{}{
+		"id": serviceID,
+	})
 }
 
-func (r *ServiceResource) updateAllowListState(plan *ServiceResourceModel, state *ServiceResourceModel) {
-	if plan.AllowList.IsNull() && len(state.AllowList.Elements()) == 0 {
-		state.AllowList = plan.AllowList
-	}
-	if !plan.AllowList.IsUnknown() && len(plan.AllowList.Elements()) == 0 && state.AllowList.IsNull() {
-		state.AllowList = plan.AllowList
+// stopServiceAfterUpdates waits for the updates of a service that was started by
+// startServiceForUpdates to complete and stops the service again.
+func (r *ServiceResource) stopServiceAfterUpdates(ctx context.Context, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	serviceID := state.ID.ValueString()
+	timeout, diags := state.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "Waiting for updates to complete before stopping service", map[string]interface{}{
+		"id": serviceID,
+	})
+	_, err := waitForServiceReady(ctx, r.client, serviceID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error stopping service after updates",
+			fmt.Sprintf("Service %q did not become ready after updates: %s", serviceID, err))
+		return
 	}
-}
 
-func (r *ServiceResource) updateServiceStorage(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Stopping service after updates", map[string]interface{}{
+		"id": serviceID,
+	})
+	err = r.client.SetServicePowerState(ctx, serviceID, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error stopping service after updates",
+			fmt.Sprintf("Unable to stop service %q after updates: %s", serviceID, err))
+		return
+	}
+
+	_, err = waitForServiceStopped(ctx, r.client, serviceID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error stopping service after updates",
+			fmt.Sprintf("Service %q did not stop after updates: %s", serviceID, err))
+		return
+	}
+
+	state.IsActive = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Service stopped after updates", map[string]interface{}{
+		"id": serviceID,
+	})
+}
+
+func (r *ServiceResource) updateAllowedAccountsState(plan *ServiceResourceModel, state *ServiceResourceModel) {
+	if plan.AllowedAccounts.IsNull() && len(state.AllowedAccounts.Elements()) == 0 {
+		state.AllowedAccounts = plan.AllowedAccounts
+	}
+	if !plan.AllowedAccounts.IsUnknown() && len(plan.AllowedAccounts.Elements()) == 0 && state.AllowedAccounts.IsNull() {
+		state.AllowedAccounts = plan.AllowedAccounts
+	}
+}
+
+func (r *ServiceResource) updateAllowListState(plan *ServiceResourceModel, state *ServiceResourceModel) {
+	if plan.AllowList.IsNull() && len(state.AllowList.Elements()) == 0 {
+		state.AllowList = plan.AllowList
+	}
+	if !plan.AllowList.IsUnknown() && len(plan.AllowList.Elements()) == 0 && state.AllowList.IsNull() {
+		state.AllowList = plan.AllowList
+	}
+}
+
+func (r *ServiceResource) updateServiceStorage(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
 	if plan.Storage.ValueInt64() != state.Storage.ValueInt64() || plan.VolumeIOPS.ValueInt64() != state.VolumeIOPS.ValueInt64() || plan.VolumeThroughput.ValueInt64() != state.VolumeThroughput.ValueInt64() {
 		tflog.Info(ctx, "Updating storage size for the service", map[string]interface{}{
 			"id":              state.ID.ValueString(),
@@ -1102,6 +2332,31 @@ func (r *ServiceResource) updateServiceSize(ctx context.Context, plan *ServiceRe
 	}
 }
 
+func (r *ServiceResource) updateServiceTier(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	if plan.Tier.IsNull() || plan.Tier.IsUnknown() || plan.Tier.Equal(state.Tier) {
+		return
+	}
+
+	tflog.Info(ctx, "Updating service tier", map[string]interface{}{
+		"id":   state.ID.ValueString(),
+		"from": state.Tier.ValueString(),
+		"to":   plan.Tier.ValueString(),
+	})
+
+	err := r.client.ModifyServiceTier(ctx, state.ID.ValueString(), plan.Tier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating service tier", fmt.Sprintf("Unable to update service tier, got error: %s", err))
+		return
+	}
+
+	state.Tier = plan.Tier
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.waitForUpdate(ctx, state, resp)
+}
+
 func (r *ServiceResource) updateServiceEndpoints(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
 	var planAllowedAccounts []string
 	d := plan.AllowedAccounts.ElementsAs(ctx, &planAllowedAccounts, false)
@@ -1119,15 +2374,18 @@ func (r *ServiceResource) updateServiceEndpoints(ctx context.Context, plan *Serv
 
 	isAllowedAccountsChanged := !reflect.DeepEqual(planAllowedAccounts, stateAllowedAccounts)
 
-	if isMechanismChanged || isAllowedAccountsChanged {
+	isVisibilityChanged := !plan.Visibility.IsUnknown() && plan.Visibility.ValueString() != state.Visibility.ValueString()
+
+	if isMechanismChanged || isAllowedAccountsChanged || isVisibilityChanged {
 		tflog.Info(ctx, "Updating service allowed accounts", map[string]interface{}{
 			"id": state.ID.ValueString(),
 		})
 
-		visibility := visibilityPublic
-		if Contains[string](privateConnectMechanisms, plan.Mechanism.ValueString()) {
-			visibility = visibilityPrivate
-		} else {
+		visibility := endpointVisibility(plan.Mechanism.ValueString())
+		if !plan.Visibility.IsUnknown() && !plan.Visibility.IsNull() {
+			visibility = plan.Visibility.ValueString()
+		}
+		if !Contains[string](privateConnectMechanisms, plan.Mechanism.ValueString()) {
 			planAllowedAccounts = []string{}
 		}
 
@@ -1135,19 +2393,34 @@ func (r *ServiceResource) updateServiceEndpoints(ctx context.Context, plan *Serv
 			planAllowedAccounts = []string{}
 		}
 
-		endpoint, err := r.client.ModifyServiceEndpoints(ctx,
-			state.ID.ValueString(),
-			plan.Mechanism.ValueString(),
-			planAllowedAccounts,
-			visibility)
+		service, err := r.client.GetServiceByID(ctx, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Can not update service", err.Error())
 			return
 		}
 
-		state.Mechanism = types.StringValue(endpoint.Mechanism)
-		r.setAllowAccounts(ctx, state, endpoint.AllowedAccounts)
-		state.EndpointService = types.StringValue(endpoint.EndpointService)
+		// The endpoints route replaces every endpoint of the service, so the other endpoints
+		// are sent unchanged next to the first one, which the flat attributes describe.
+		endpoints := make([]provisioning.ServiceEndpoint, 0, len(service.Endpoints))
+		for _, endpoint := range service.Endpoints {
+			endpoints = append(endpoints, serviceEndpointFromEndpoint(endpoint))
+		}
+		if len(endpoints) == 0 {
+			endpoints = append(endpoints, provisioning.ServiceEndpoint{Name: defaultEndpointName})
+		}
+		endpoints[0].Mechanism = plan.Mechanism.ValueString()
+		endpoints[0].AllowedAccounts = planAllowedAccounts
+		endpoints[0].Visibility = visibility
+
+		_, err = r.client.ModifyServiceEndpoints(ctx, state.ID.ValueString(), endpoints)
+		if err != nil {
+			resp.Diagnostics.AddError("Can not update service", err.Error())
+			return
+		}
+
+		state.Mechanism = types.StringValue(endpoints[0].Mechanism)
+		state.Visibility = types.StringValue(endpoints[0].Visibility)
+		r.setAllowAccounts(ctx, state, endpoints[0].AllowedAccounts)
 
 		// Save updated data into Terraform state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -1159,6 +2432,32 @@ func (r *ServiceResource) updateServiceEndpoints(ctx context.Context, plan *Serv
 	}
 }
 
+func (r *ServiceResource) updateServiceEndpointList(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	changed, diags := endpointsChanged(ctx, plan.Endpoints, state.Endpoints)
+	resp.Diagnostics.Append(diags...)
+	if !changed || resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updating service endpoints", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	endpoints, diags := endpointsRequest(ctx, plan.Endpoints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.ModifyServiceEndpoints(ctx, state.ID.ValueString(), endpoints)
+	if err != nil {
+		resp.Diagnostics.AddError("Can not update service", err.Error())
+		return
+	}
+
+	r.waitForUpdate(ctx, state, resp)
+}
+
 func (r *ServiceResource) updateAllowList(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
 	if !plan.AllowList.IsUnknown() {
 		var planAllowList []AllowListModel
@@ -1239,6 +2538,39 @@ func (r *ServiceResource) updateServicePowerState(ctx context.Context, plan *Ser
 	}
 }
 
+func (r *ServiceResource) updateServiceNoSQL(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	// Removing nosql_enabled from the configuration leaves the interface as it is.
+	if plan.NoSQLEnabled.IsNull() || plan.NoSQLEnabled.IsUnknown() || plan.NoSQLEnabled.ValueBool() == state.NoSQLEnabled.ValueBool() {
+		state.NoSQLEnabled = plan.NoSQLEnabled
+		return
+	}
+
+	tflog.Info(ctx, "Updating service NoSQL interface", map[string]interface{}{
+		"id":            state.ID.ValueString(),
+		"nosql_enabled": plan.NoSQLEnabled.ValueBool(),
+	})
+	err := r.client.SetServiceNoSQL(ctx, state.ID.ValueString(), plan.NoSQLEnabled.ValueBool())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error updating service NoSQL interface",
+			fmt.Sprintf("Unable to update the NoSQL interface of service %q: %s", state.ID.ValueString(), err))
+		return
+	}
+	state.NoSQLEnabled = plan.NoSQLEnabled
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.waitForUpdate(ctx, state, resp)
+}
+
 func (r *ServiceResource) updateServiceTags(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
 	// If user removed tags from config entirely, stop managing them
 	if plan.Tags.IsNull() || plan.Tags.IsUnknown() {
@@ -1364,6 +2696,90 @@ func (r *ServiceResource) updateServiceConfig(ctx context.Context, plan *Service
 
 var serviceUpdateWaitStates = []string{"ready", "failed", "stopped"}
 
+func (r *ServiceResource) updateServiceMaintenanceWindow(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	if plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		return
+	}
+
+	serviceID := state.ID.ValueString()
+
+	window, diags := plan.maintenanceWindow(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if window == nil {
+		tflog.Info(ctx, "Reverting service to the default maintenance window", map[string]interface{}{
+			"id": serviceID,
+		})
+		err = r.client.DeleteServiceMaintenanceWindow(ctx, serviceID)
+	} else {
+		tflog.Info(ctx, "Updating service maintenance window", map[string]interface{}{
+			"id": serviceID,
+		})
+		err = r.client.UpdateServiceMaintenanceWindow(ctx, serviceID, window)
+	}
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
+				"id": serviceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error updating service maintenance window",
+			fmt.Sprintf("Unable to update the maintenance window of service %q: %s", serviceID, err))
+		return
+	}
+
+	state.MaintenanceWindow = plan.MaintenanceWindow
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ServiceResource) updateServiceServerless(ctx context.Context, plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.UpdateResponse) {
+	if plan.Serverless.Equal(state.Serverless) {
+		return
+	}
+
+	serviceID := state.ID.ValueString()
+
+	settings, diags := plan.serverless(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if settings == nil {
+		tflog.Info(ctx, "Reverting service to the default serverless settings", map[string]interface{}{
+			"id": serviceID,
+		})
+		err = r.client.DeleteServiceServerless(ctx, serviceID)
+	} else {
+		tflog.Info(ctx, "Updating service serverless settings", map[string]interface{}{
+			"id": serviceID,
+		})
+		err = r.client.UpdateServiceServerless(ctx, serviceID, settings)
+	}
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing from state", map[string]interface{}{
+				"id": serviceID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error updating service serverless settings",
+			fmt.Sprintf("Unable to update the serverless settings of service %q: %s", serviceID, err))
+		return
+	}
+
+	state.Serverless = plan.Serverless
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
 func (r *ServiceResource) waitForUpdate(ctx context.Context, state *ServiceResourceModel, resp *resource.UpdateResponse) {
 	if state.WaitForUpdate.ValueBool() {
 		err := sdkresource.RetryContext(ctx, defaultUpdateTimeout, func() *sdkresource.RetryError {
@@ -1389,6 +2805,67 @@ func (r *ServiceResource) waitForUpdate(ctx context.Context, state *ServiceResou
 	}
 }
 
+// waitForServiceReady polls a service until it is ready, fails or the timeout elapses.
+func waitForServiceReady(ctx context.Context, client *skysql.Client, serviceID string, timeout time.Duration) (*provisioning.Service, error) {
+	var result *provisioning.Service
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		service, err := client.GetServiceByID(ctx, serviceID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		switch service.Status {
+		case "ready":
+			result = service
+			return nil
+		case "failed":
+			return sdkresource.NonRetryableError(fmt.Errorf("service %s failed", serviceID))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected instance to be ready but was in state %s", service.Status))
+	})
+
+	return result, err
+}
+
+// waitForServiceStopped polls a service until it is stopped, fails or the timeout elapses.
+func waitForServiceStopped(ctx context.Context, client *skysql.Client, serviceID string, timeout time.Duration) (*provisioning.Service, error) {
+	var result *provisioning.Service
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		service, err := client.GetServiceByID(ctx, serviceID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		switch service.Status {
+		case "stopped":
+			result = service
+			return nil
+		case "failed":
+			return sdkresource.NonRetryableError(fmt.Errorf("service %s failed", serviceID))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected instance to be stopped but was in state %s", service.Status))
+	})
+
+	return result, err
+}
+
+// waitForServiceDeleted polls the service until the API no longer returns it.
+func waitForServiceDeleted(ctx context.Context, client *skysql.Client, serviceID string, timeout time.Duration) error {
+	return sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		service, err := client.GetServiceByID(ctx, serviceID)
+		if err != nil {
+			if errors.Is(err, skysql.ErrorServiceNotFound) {
+				return nil
+			}
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving service details: %v", err))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected that the instance was deleted, but it was in state %s", service.Status))
+	})
+}
+
 func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state *ServiceResourceModel
 
@@ -1404,6 +2881,13 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if state.FinalBackup.ValueBool() {
+		r.takeFinalBackup(ctx, state, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	err := r.client.DeleteServiceByID(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, skysql.ErrorServiceNotFound) {
@@ -1442,8 +2926,65 @@ func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// takeFinalBackup backs up the service before it is deleted and waits for the
+// backup to succeed, so a failed backup leaves the service in place.
+// The wait has its own deadline of the delete timeout; the wait for the deletion starts a new one.
+func (r *ServiceResource) takeFinalBackup(ctx context.Context, state *ServiceResourceModel, resp *resource.DeleteResponse) {
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Taking final backup before deleting service", map[string]interface{}{
+		"service_id": state.ID.ValueString(),
+	})
+
+	b, err := r.client.CreateBackup(ctx, &backup.CreateBackupRequest{
+		ServiceID:     state.ID.ValueString(),
+		Type:          backup.TypeFull,
+		Name:          state.FinalBackupName.ValueString(),
+		RetentionDays: state.FinalBackupDays.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Can not delete service",
+			fmt.Sprintf("Unable to take the final backup of service %q: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	_, err = waitForBackup(ctx, r.client, b.ID, deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Can not delete service",
+			fmt.Sprintf("The final backup of service %q did not complete, the service was not deleted: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Info(ctx, "Final backup completed", map[string]interface{}{
+		"service_id": state.ID.ValueString(),
+		"backup_id":  b.ID,
+	})
+}
+
 func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// encryption and maintenance_window are only refreshed once they are tracked, so an imported service
+	// starts with the key and the window it reports.
+	service, err := r.client.GetServiceByID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Can not import service", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("encryption"), encryptionToObject(service.Encryption))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("maintenance_window"), maintenanceWindowToObject(service.MaintenanceWindow))...)
+	// replication_enabled and primary_host are never refreshed, so an imported replica starts with its current primary.
+	if service.ReplicationEnabled {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replication_enabled"), types.BoolValue(true))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("primary_host"), stringValueOrNull(service.PrimaryHost))...)
+	}
 }
 
 func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -1698,6 +3239,401 @@ func (r *ServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		plan.Mechanism.ValueString() == state.Mechanism.ValueString() {
 		resp.Plan.SetAttribute(ctx, path.Root("endpoint_service"), state.EndpointService)
 	}
+
+	// An unset endpoint_visibility follows endpoint_mechanism when the mechanism changes.
+	if state != nil && config.Visibility.IsNull() && !plan.Mechanism.IsUnknown() && plan.Mechanism.ValueString() != "" &&
+		!plan.Mechanism.Equal(state.Mechanism) {
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_visibility"), types.StringValue(endpointVisibility(plan.Mechanism.ValueString())))
+	}
+
+	if config.Visibility.ValueString() == visibilityPublic && Contains[string](privateConnectMechanisms, plan.Mechanism.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint_visibility"),
+			"Invalid configuration",
+			fmt.Sprintf("endpoint_visibility = %q cannot be used with endpoint_mechanism = %q, which is always private",
+				visibilityPublic, plan.Mechanism.ValueString()))
+		return
+	}
+
+	r.modifyEndpointsPlan(ctx, state, config, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.FinalBackup.ValueBool() {
+		if !plan.FinalBackupName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("final_backup_name"),
+				"Invalid configuration",
+				"final_backup_name requires final_backup = true")
+		}
+		if !plan.FinalBackupDays.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("final_backup_retention_days"),
+				"Invalid configuration",
+				"final_backup_retention_days requires final_backup = true")
+		}
+	}
+
+	encryption, diags := plan.encryption(ctx)
+	resp.Diagnostics.Append(diags...)
+	if encryption != nil && !plan.Provider.IsUnknown() {
+		kmsKeyID := plan.Encryption.Attributes()["kms_key_id"]
+		if !kmsKeyID.IsUnknown() {
+			if err := validateKMSKeyID(plan.Provider.ValueString(), encryption.KMSKeyID); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("encryption").AtName("kms_key_id"),
+					"Invalid configuration", err.Error())
+			}
+		}
+	}
+
+	r.validateServerless(ctx, plan, resp)
+	r.validateTier(ctx, plan, resp)
+
+	r.validateNoSQL(plan, state, resp)
+
+	if plan.IgnoreIsActive.ValueBool() && !config.IsActive.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("is_active"),
+			"Invalid configuration",
+			"is_active cannot be set when ignore_is_active = true, the power state is managed outside of this resource")
+	}
+
+	if state == nil {
+		r.validateRestoreFrom(ctx, plan, resp)
+	} else {
+		r.keepRefreshedAttributes(ctx, req, resp)
+		r.ignoreIsActive(ctx, req, resp)
+	}
+}
+
+// validateNoSQL rejects nosql_enabled for topologies without the NoSQL interface. Existing services are only
+// checked when nosql_enabled or the topology changes, so services the API accepted are never blocked by the list.
+// The topologies API does not report which topologies serve the interface, so the API remains the authority
+// when nosql_enabled is sent to it.
+func (r *ServiceResource) validateNoSQL(plan *ServiceResourceModel, state *ServiceResourceModel, resp *resource.ModifyPlanResponse) {
+	if !plan.NoSQLEnabled.ValueBool() || plan.Topology.IsUnknown() {
+		return
+	}
+	if state != nil && plan.NoSQLEnabled.Equal(state.NoSQLEnabled) && plan.Topology.Equal(state.Topology) {
+		return
+	}
+	if !Contains[string](nosqlTopologies, plan.Topology.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("nosql_enabled"),
+			"Invalid configuration",
+			fmt.Sprintf("nosql_enabled is only supported for the %s topologies, not for %q",
+				strings.Join(nosqlTopologies, " and "), plan.Topology.ValueString()))
+	}
+}
+
+// ignoreIsActive plans is_active as unknown when the service changes and its power state is managed
+// elsewhere, so that a service started or stopped during the same apply is not reported as inconsistent.
+func (r *ServiceResource) ignoreIsActive(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var ignore types.Bool
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("ignore_is_active"), &ignore)...)
+	if resp.Diagnostics.HasError() || !ignore.ValueBool() {
+		return
+	}
+
+	diffs, err := resp.Plan.Raw.Diff(req.State.Raw)
+	if err != nil || len(diffs) == 0 {
+		return
+	}
+	resp.Plan.SetAttribute(ctx, path.Root("is_active"), types.BoolUnknown())
+}
+
+// validateServerless checks that serverless is only set on serverless topologies
+// and that its capacity range is not empty.
+func (r *ServiceResource) validateServerless(ctx context.Context, plan *ServiceResourceModel, resp *resource.ModifyPlanResponse) {
+	if plan.Serverless.IsNull() || plan.Serverless.IsUnknown() {
+		return
+	}
+
+	if !plan.Topology.IsUnknown() && !Contains[string](serverlessTopologies, plan.Topology.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("serverless"),
+			"Invalid configuration",
+			fmt.Sprintf("serverless is only supported for the serverless-standalone topology, not for %q", plan.Topology.ValueString()))
+		return
+	}
+
+	var serverless ServiceServerlessModel
+	resp.Diagnostics.Append(plan.Serverless.As(ctx, &serverless, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || serverless.MinCapacity.IsUnknown() || serverless.MaxCapacity.IsUnknown() {
+		return
+	}
+
+	if serverless.MinCapacity.ValueInt64() > serverless.MaxCapacity.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("serverless").AtName("max_capacity"),
+			"Invalid configuration",
+			fmt.Sprintf("max_capacity (%d) must not be lower than min_capacity (%d)",
+				serverless.MaxCapacity.ValueInt64(), serverless.MinCapacity.ValueInt64()))
+	}
+}
+
+// validateTier checks that a service planned in the foundation tier, whether configured or kept
+// from state, does not use features of the power tier: multi-node topologies and private endpoints.
+func (r *ServiceResource) validateTier(ctx context.Context, plan *ServiceResourceModel, resp *resource.ModifyPlanResponse) {
+	// An unknown tier is the default tier of the organization, which is only known after apply.
+	if plan.Tier.IsUnknown() || plan.Tier.ValueString() != tierFoundation {
+		return
+	}
+
+	if Contains[string](multiNodeTopologies, plan.Topology.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("topology"),
+			"Invalid configuration",
+			fmt.Sprintf("topology %q is not available in the foundation tier, use tier = %q", plan.Topology.ValueString(), tierPower))
+	}
+
+	if plan.Nodes.ValueInt64() > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("nodes"),
+			"Invalid configuration",
+			fmt.Sprintf("a foundation tier service has a single node, use tier = %q for %d nodes", tierPower, plan.Nodes.ValueInt64()))
+	}
+
+	if Contains[string](privateConnectMechanisms, plan.Mechanism.ValueString()) || plan.Visibility.ValueString() == visibilityPrivate {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint_mechanism"),
+			"Invalid configuration",
+			fmt.Sprintf("private endpoints are not available in the foundation tier, use tier = %q", tierPower))
+		return
+	}
+
+	if plan.Endpoints.IsNull() || plan.Endpoints.IsUnknown() {
+		return
+	}
+	var endpoints []ServiceEndpointModel
+	resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &endpoints, false)...)
+	for _, endpoint := range endpoints {
+		if Contains[string](privateConnectMechanisms, endpoint.Mechanism.ValueString()) || endpoint.Visibility.ValueString() == visibilityPrivate {
+			resp.Diagnostics.AddAttributeError(path.Root("endpoints"),
+				"Invalid configuration",
+				fmt.Sprintf("endpoint %q is private, private endpoints are not available in the foundation tier, use tier = %q",
+					endpoint.Name.ValueString(), tierPower))
+			return
+		}
+	}
+}
+
+// refreshedAttributes are computed attributes that are read again after every update.
+var refreshedAttributes = []string{
+	"replication_state",
+	"replication_lag_seconds",
+	"replication_last_error",
+	"read_write_port",
+	"read_only_port",
+	"nosql_port",
+	"ports",
+	"connection_uri",
+	"jdbc_connection_uri",
+}
+
+// keepRefreshedAttributes plans the refreshed attributes from state when nothing else changes.
+// They are otherwise known only after apply.
+func (r *ServiceResource) keepRefreshedAttributes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	diffs, err := resp.Plan.Raw.Diff(req.State.Raw)
+	if err != nil {
+		return
+	}
+	for _, d := range diffs {
+		steps := d.Path.Steps()
+		if len(steps) == 0 {
+			continue
+		}
+		name, ok := steps[0].(tftypes.AttributeName)
+		if !ok || !Contains[string](refreshedAttributes, string(name)) {
+			return
+		}
+	}
+
+	var state *ServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Plan.SetAttribute(ctx, path.Root("replication_state"), state.ReplicationState)
+	resp.Plan.SetAttribute(ctx, path.Root("replication_lag_seconds"), state.ReplicationLagSeconds)
+	resp.Plan.SetAttribute(ctx, path.Root("replication_last_error"), state.ReplicationLastError)
+	resp.Plan.SetAttribute(ctx, path.Root("read_write_port"), state.ReadWritePort)
+	resp.Plan.SetAttribute(ctx, path.Root("read_only_port"), state.ReadOnlyPort)
+	resp.Plan.SetAttribute(ctx, path.Root("nosql_port"), state.NoSQLPort)
+	resp.Plan.SetAttribute(ctx, path.Root("ports"), state.Ports)
+	resp.Plan.SetAttribute(ctx, path.Root("connection_uri"), state.ConnectionURI)
+	resp.Plan.SetAttribute(ctx, path.Root("jdbc_connection_uri"), state.JDBCConnectionURI)
+}
+
+// modifyEndpointsPlan keeps the endpoints attribute and the flat endpoint attributes, which
+// describe the first endpoint, consistent with each other. Whichever of the two is configured
+// drives the plan; the other one is marked unknown when it changes.
+func (r *ServiceResource) modifyEndpointsPlan(ctx context.Context, state *ServiceResourceModel, config *ServiceResourceModel, resp *resource.ModifyPlanResponse) {
+	// The flat endpoint attributes may already have been modified in the plan.
+	var plan *ServiceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Endpoints.IsNull() {
+		if state != nil && (!plan.Mechanism.Equal(state.Mechanism) ||
+			!plan.AllowedAccounts.Equal(state.AllowedAccounts) ||
+			!plan.Visibility.Equal(state.Visibility) ||
+			!plan.AllowList.Equal(state.AllowList)) {
+			resp.Plan.SetAttribute(ctx, path.Root("endpoints"), types.ListUnknown(serviceEndpointElementType))
+		}
+		return
+	}
+
+	if !config.Mechanism.IsNull() || !config.AllowedAccounts.IsNull() || !config.Visibility.IsNull() || !config.AllowList.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoints"),
+			"Invalid configuration",
+			"endpoints cannot be combined with endpoint_mechanism, endpoint_allowed_accounts, endpoint_visibility or allow_list")
+		return
+	}
+
+	if plan.Endpoints.IsUnknown() {
+		return
+	}
+
+	var planned []ServiceEndpointModel
+	resp.Diagnostics.Append(plan.Endpoints.ElementsAs(ctx, &planned, false)...)
+	var configured []ServiceEndpointModel
+	resp.Diagnostics.Append(config.Endpoints.ElementsAs(ctx, &configured, false)...)
+	var current []ServiceEndpointModel
+	if state != nil && !state.Endpoints.IsNull() {
+		resp.Diagnostics.Append(state.Endpoints.ElementsAs(ctx, &current, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]bool, len(planned))
+	for i := range planned {
+		endpoint := &planned[i]
+		endpointPath := path.Root("endpoints").AtListIndex(i)
+		if !endpoint.Name.IsUnknown() {
+			if names[endpoint.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(endpointPath.AtName("name"),
+					"Invalid configuration",
+					fmt.Sprintf("Endpoint %q is defined more than once", endpoint.Name.ValueString()))
+			}
+			names[endpoint.Name.ValueString()] = true
+		}
+
+		if !endpoint.Mechanism.IsUnknown() {
+			private := Contains[string](privateConnectMechanisms, endpoint.Mechanism.ValueString())
+			if private && !endpoint.AllowList.IsNull() {
+				resp.Diagnostics.AddAttributeError(endpointPath.AtName("allow_list"),
+					"Invalid configuration",
+					fmt.Sprintf("allow_list cannot be used with mechanism %q, use allowed_accounts instead", endpoint.Mechanism.ValueString()))
+			}
+			if !private && !endpoint.AllowedAccounts.IsNull() {
+				resp.Diagnostics.AddAttributeError(endpointPath.AtName("allowed_accounts"),
+					"Invalid configuration",
+					fmt.Sprintf("allowed_accounts cannot be used with mechanism %q", endpoint.Mechanism.ValueString()))
+			}
+			// An unset visibility follows the mechanism, also when the mechanism changes.
+			if i < len(configured) && configured[i].Visibility.IsNull() {
+				endpoint.Visibility = types.StringValue(endpointVisibility(endpoint.Mechanism.ValueString()))
+			}
+			if private && endpoint.Visibility.ValueString() == visibilityPublic {
+				resp.Diagnostics.AddAttributeError(endpointPath.AtName("visibility"),
+					"Invalid configuration",
+					fmt.Sprintf("visibility = %q cannot be used with mechanism %q, which is always private",
+						visibilityPublic, endpoint.Mechanism.ValueString()))
+			}
+		}
+
+		// The endpoint service is kept only while the endpoint keeps its name and mechanism.
+		endpoint.EndpointService = types.StringUnknown()
+		for _, existing := range current {
+			if existing.Name.Equal(endpoint.Name) && existing.Mechanism.Equal(endpoint.Mechanism) {
+				endpoint.EndpointService = existing.EndpointService
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoints, diags := types.ListValueFrom(ctx, serviceEndpointElementType, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("endpoints"), endpoints)...)
+
+	changed := true
+	if state != nil {
+		changed, diags = endpointsChanged(ctx, endpoints, state.Endpoints)
+		resp.Diagnostics.Append(diags...)
+	}
+	if changed {
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_mechanism"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_allowed_accounts"), types.ListUnknown(types.StringType))
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_visibility"), types.StringUnknown())
+		resp.Plan.SetAttribute(ctx, path.Root("allow_list"), types.ListUnknown(allowListElementType))
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_service"), types.StringUnknown())
+	} else {
+		resp.Plan.SetAttribute(ctx, path.Root("endpoint_allowed_accounts"), state.AllowedAccounts)
+	}
+}
+
+// validateRestoreFrom checks that the backup in restore_from can be restored
+// into the planned service. Lookups that fail for reasons other than a missing
+// backup are deferred to the restore API.
+func (r *ServiceResource) validateRestoreFrom(ctx context.Context, plan *ServiceResourceModel, resp *resource.ModifyPlanResponse) {
+	backupID, diags := plan.restoreFromBackupID(ctx)
+	resp.Diagnostics.Append(diags...)
+	if backupID == "" || r.client == nil {
+		return
+	}
+
+	backupIDPath := path.Root("restore_from").AtName("backup_id")
+
+	b, err := r.client.GetBackupByID(ctx, backupID)
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			resp.Diagnostics.AddAttributeError(backupIDPath,
+				"Backup not found",
+				fmt.Sprintf("The backup %q does not exist.", backupID))
+			return
+		}
+		tflog.Warn(ctx, "unable to verify backup compatibility, deferring to the restore API: "+err.Error())
+		return
+	}
+
+	if b.Status != backup.StatusSucceeded {
+		resp.Diagnostics.AddAttributeError(backupIDPath,
+			"Backup cannot be restored",
+			fmt.Sprintf("The backup %q is in %q state. Only succeeded backups can be restored.", backupID, b.Status))
+		return
+	}
+
+	topology, version := b.Topology, b.Version
+	if topology == "" || version == "" {
+		// Fall back to the backed up service when the backup does not record it.
+		source, err := r.client.GetServiceByID(ctx, b.ServiceID)
+		if err != nil {
+			tflog.Warn(ctx, "unable to read the backed up service, deferring to the restore API: "+err.Error())
+		} else {
+			if topology == "" {
+				topology = source.Topology
+			}
+			if version == "" {
+				version = source.Version
+			}
+		}
+	}
+
+	if topology != "" && !plan.Topology.IsUnknown() && topology != plan.Topology.ValueString() {
+		resp.Diagnostics.AddAttributeError(backupIDPath,
+			"Incompatible backup topology",
+			fmt.Sprintf("The backup %q was taken from a %q service and cannot be restored into a %q service.",
+				backupID, topology, plan.Topology.ValueString()))
+	}
+
+	if version != "" && !plan.Version.IsUnknown() && !plan.Version.IsNull() &&
+		serverVersionSeries(version) != serverVersionSeries(plan.Version.ValueString()) {
+		resp.Diagnostics.AddAttributeError(backupIDPath,
+			"Incompatible backup version",
+			fmt.Sprintf("The backup %q was taken from server version %q and cannot be restored into version %q. "+
+				"The service must run the same major and minor version as the backup.",
+				backupID, version, plan.Version.ValueString()))
+	}
 }
 
 func (r *ServiceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -1711,11 +3647,16 @@ func (r *ServiceResource) UpgradeState(ctx context.Context) map[int64]resource.S
 				if resp.Diagnostics.HasError() {
 					return
 				}
+				state.Endpoints, diags = endpointsFromFlatAttributes(ctx, &state)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
 				if state.Provider.ValueString() == "gcp" {
 					state.VolumeType = types.StringValue("pd-ssd")
-					diags = resp.State.Set(ctx, state)
-					resp.Diagnostics.Append(diags...)
 				}
+				diags = resp.State.Set(ctx, state)
+				resp.Diagnostics.Append(diags...)
 			},
 		},
 		1: {
@@ -1728,45 +3669,87 @@ func (r *ServiceResource) UpgradeState(ctx context.Context) map[int64]resource.S
 					return
 				}
 				newState := ServiceResourceModel{
-					ID:                 oldState.ID,
-					Name:               oldState.Name,
-					ProjectID:          oldState.ProjectID,
-					ServiceType:        oldState.ServiceType,
-					Provider:           oldState.Provider,
-					Region:             oldState.Region,
-					Version:            oldState.Version,
-					Nodes:              oldState.Nodes,
-					Architecture:       oldState.Architecture,
-					Size:               oldState.Size,
-					Topology:           oldState.Topology,
-					Storage:            oldState.Storage,
-					VolumeIOPS:         oldState.VolumeIOPS,
-					VolumeThroughput:   oldState.VolumeThroughput,
-					SSLEnabled:         oldState.SSLEnabled,
-					NoSQLEnabled:       oldState.NoSQLEnabled,
-					VolumeType:         oldState.VolumeType,
-					WaitForCreation:    oldState.WaitForCreation,
-					Timeouts:           oldState.Timeouts,
-					Mechanism:          oldState.Mechanism,
-					AllowedAccounts:    oldState.AllowedAccounts,
-					EndpointService:    oldState.EndpointService,
-					WaitForDeletion:    oldState.WaitForDeletion,
-					ReplicationEnabled: oldState.ReplicationEnabled,
-					PrimaryHost:        oldState.PrimaryHost,
-					IsActive:           oldState.IsActive,
-					WaitForUpdate:      oldState.WaitForUpdate,
-					DeletionProtection: oldState.DeletionProtection,
-					AllowList:          oldState.AllowList,
-					MaxscaleNodes:      oldState.MaxscaleNodes,
-					MaxscaleSize:       oldState.MaxscaleSize,
-					FQDN:               oldState.FQDN,
-					AvailabilityZone:   oldState.AvailabilityZone,
-					Tags:               oldState.Tags,
-					ConfigID:           oldState.ConfigID,
+					ID:                        oldState.ID,
+					Name:                      oldState.Name,
+					ProjectID:                 oldState.ProjectID,
+					ServiceType:               oldState.ServiceType,
+					Provider:                  oldState.Provider,
+					Region:                    oldState.Region,
+					Version:                   oldState.Version,
+					Nodes:                     oldState.Nodes,
+					Architecture:              oldState.Architecture,
+					Size:                      oldState.Size,
+					Topology:                  oldState.Topology,
+					Storage:                   oldState.Storage,
+					VolumeIOPS:                oldState.VolumeIOPS,
+					VolumeThroughput:          oldState.VolumeThroughput,
+					SSLEnabled:                oldState.SSLEnabled,
+					NoSQLEnabled:              oldState.NoSQLEnabled,
+					VolumeType:                oldState.VolumeType,
+					WaitForCreation:           oldState.WaitForCreation,
+					Timeouts:                  oldState.Timeouts,
+					Mechanism:                 oldState.Mechanism,
+					AllowedAccounts:           oldState.AllowedAccounts,
+					Visibility:                oldState.Visibility,
+					EndpointService:           oldState.EndpointService,
+					WaitForDeletion:           oldState.WaitForDeletion,
+					ReplicationEnabled:        oldState.ReplicationEnabled,
+					PrimaryHost:               oldState.PrimaryHost,
+					WaitForReplicationHealthy: oldState.WaitForReplicationHealthy,
+					ReplicationState:          oldState.ReplicationState,
+					ReplicationLagSeconds:     oldState.ReplicationLagSeconds,
+					ReplicationLastError:      oldState.ReplicationLastError,
+					IsActive:                  oldState.IsActive,
+					WaitForUpdate:             oldState.WaitForUpdate,
+					DeletionProtection:        oldState.DeletionProtection,
+					FinalBackup:               oldState.FinalBackup,
+					FinalBackupName:           oldState.FinalBackupName,
+					FinalBackupDays:           oldState.FinalBackupDays,
+					AllowList:                 oldState.AllowList,
+					MaxscaleNodes:             oldState.MaxscaleNodes,
+					MaxscaleSize:              oldState.MaxscaleSize,
+					FQDN:                      oldState.FQDN,
+					ReadWritePort:             oldState.ReadWritePort,
+					ReadOnlyPort:              oldState.ReadOnlyPort,
+					NoSQLPort:                 oldState.NoSQLPort,
+					Ports:                     oldState.Ports,
+					ConnectionURI:             oldState.ConnectionURI,
+					JDBCConnectionURI:         oldState.JDBCConnectionURI,
+					AvailabilityZone:          oldState.AvailabilityZone,
+					Tags:                      oldState.Tags,
+					ConfigID:                  oldState.ConfigID,
+					RestoreFrom:               oldState.RestoreFrom,
+					MaintenanceWindow:         oldState.MaintenanceWindow,
+					Encryption:                oldState.Encryption,
+					Serverless:                oldState.Serverless,
+					Tier:                      oldState.Tier,
+					AutoStartForUpdates:       oldState.AutoStartForUpdates,
+					IgnoreIsActive:            oldState.IgnoreIsActive,
 				}
+				newState.Endpoints, diags = endpointsFromFlatAttributes(ctx, &newState)
+				resp.Diagnostics.Append(diags...)
 				diags = resp.State.Set(ctx, newState)
 				resp.Diagnostics.Append(diags...)
 			},
 		},
+		// Version 3 added endpoints, which is built from the flat attributes of the first endpoint.
+		2: {
+			PriorSchema: &serviceResourceSchemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var state ServiceResourceModel
+				diags := req.State.Get(ctx, &state)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				state.Endpoints, diags = endpointsFromFlatAttributes(ctx, &state)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				diags = resp.State.Set(ctx, state)
+				resp.Diagnostics.Append(diags...)
+			},
+		},
 	}
 }
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceResourceConnectionDetails(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002500"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-connection"
+	service.FQDN = "dbdgf42002500.sysp0000.db1.skysql.com"
+	service.Endpoints[0].Ports = []provisioning.Port{
+		{Name: "readwrite", Port: 3306, Purpose: provisioning.PortPurposeReadWrite},
+		{Name: "readonly", Port: 3307, Purpose: provisioning.PortPurposeReadOnly},
+		{Name: "nosql", Port: 27017, Purpose: provisioning.PortPurposeNoSQL},
+	}
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply; the connection details must not show up as a change
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type        = "transactional"
+					topology            = "es-single"
+					cloud_provider      = "gcp"
+					region              = "us-central1"
+					name                = "test-connection"
+					architecture        = "amd64"
+					nodes               = 1
+					size                = "sky-2x8"
+					storage             = 100
+					ssl_enabled         = true
+					version             = "10.6.11-6-1"
+					wait_for_creation   = true
+					wait_for_deletion   = true
+					deletion_protection = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "read_write_port", "3306"),
+					resource.TestCheckResourceAttr("skysql_service.default", "read_only_port", "3307"),
+					resource.TestCheckResourceAttr("skysql_service.default", "nosql_port", "27017"),
+					resource.TestCheckResourceAttr("skysql_service.default", "ports.%", "3"),
+					resource.TestCheckResourceAttr("skysql_service.default", "ports.readonly", "3307"),
+					resource.TestCheckResourceAttr("skysql_service.default", "connection_uri",
+						"mysql://dbdgf42002500.sysp0000.db1.skysql.com:3306?ssl-mode=VERIFY_IDENTITY"),
+					resource.TestCheckResourceAttr("skysql_service.default", "jdbc_connection_uri",
+						"jdbc:mariadb://dbdgf42002500.sysp0000.db1.skysql.com:3306/?sslMode=verify-full"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+const testGCPKMSKeyID = "projects/my-project/locations/us-central1/keyRings/skysql/cryptoKeys/storage"
+
+func encryptionTestConfig(kmsKeyID string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-encryption"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		encryption = {
+			kms_key_id = %q
+		}
+	}`, kmsKeyID)
+}
+
+func TestServiceResourceEncryption(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002530"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-encryption"
+	service.Encryption = &provisioning.Encryption{KMSKeyID: testGCPKMSKeyID}
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the key is sent with the create request
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(&provisioning.Encryption{KMSKeyID: testGCPKMSKeyID}, payload.Encryption)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Step 2: the key was rotated outside of Terraform, which plans a replacement
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		service.Encryption = &provisioning.Encryption{KMSKeyID: testGCPKMSKeyID + "-rotated"}
+		getService(w, req)
+	})
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: encryptionTestConfig(testGCPKMSKeyID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "encryption.kms_key_id", testGCPKMSKeyID),
+				),
+			},
+			{
+				Config:             encryptionTestConfig(testGCPKMSKeyID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestServiceResourceEncryption_InvalidKey(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      encryptionTestConfig("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				ExpectError: regexp.MustCompile(`is not a GCP Cloud KMS key name`),
+			},
+		},
+	})
+}
+
+func TestValidateKMSKeyID(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(validateKMSKeyID("aws", "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"))
+	r.NoError(validateKMSKeyID("aws", "arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"))
+	r.NoError(validateKMSKeyID("gcp", testGCPKMSKeyID))
+	r.NoError(validateKMSKeyID("azure", "https://my-vault.vault.azure.net/keys/storage"))
+	r.NoError(validateKMSKeyID("azure", "https://my-vault.vault.azure.net/keys/storage/0123456789abcdef0123456789abcdef"))
+
+	r.Error(validateKMSKeyID("aws", "1234abcd-12ab-34cd-56ef-1234567890ab"))
+	r.Error(validateKMSKeyID("aws", "arn:aws:kms:us-east-1:123456789012:alias/skysql"))
+	r.Error(validateKMSKeyID("gcp", "projects/my-project/keyRings/skysql/cryptoKeys/storage"))
+	r.Error(validateKMSKeyID("azure", "https://my-vault.example.com/keys/storage"))
+}
+
+func TestRefreshEncryption(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	const azureKey = "https://my-vault.vault.azure.net/keys/storage"
+	configured := encryptionToObject(&provisioning.Encryption{KMSKeyID: azureKey})
+
+	// The version the service reports for a key configured without one is not drift
+	refreshed := refreshEncryption(ctx, configured, &provisioning.Encryption{KMSKeyID: azureKey + "/0123456789abcdef0123456789abcdef"})
+	r.True(refreshed.Equal(configured))
+
+	// Another key is reported as drift
+	other := &provisioning.Encryption{KMSKeyID: "https://my-vault.vault.azure.net/keys/other"}
+	r.True(refreshEncryption(ctx, configured, other).Equal(encryptionToObject(other)))
+
+	// A service that is no longer encrypted with the key is reported as drift
+	r.True(refreshEncryption(ctx, configured, nil).IsNull())
+}
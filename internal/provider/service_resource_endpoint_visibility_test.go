@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func endpointVisibilityTestConfig(mechanism string, visibility string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-visibility"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		endpoint_mechanism  = %q
+		endpoint_visibility = %q
+	}`, mechanism, visibility)
+}
+
+func TestServiceResourceEndpointVisibility(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002510"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-visibility"
+	service.Endpoints[0].Mechanism = "nlb"
+	service.Endpoints[0].Visibility = "public"
+	// An endpoint managed outside of the flat attributes, for example by skysql_private_endpoint.
+	service.Endpoints = append(service.Endpoints, provisioning.Endpoint{
+		Name:            "private",
+		Mechanism:       "privateconnect",
+		AllowedAccounts: []string{"my-gcp-project"},
+		Visibility:      "private",
+	})
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the visibility is sent with the create request
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("nlb", payload.Mechanism)
+		r.Equal("public", payload.Visibility)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the endpoint is made private in place
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPatch, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/endpoints", req.URL.Path)
+
+		var payload provisioning.PatchServiceEndpointsRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		// The other endpoints are sent unchanged so that they are not removed.
+		r.Len(payload, 2)
+		r.Equal("primary", payload[0].Name)
+		r.Equal("nlb", payload[0].Mechanism)
+		r.Equal("private", payload[0].Visibility)
+		r.Equal("private", payload[1].Name)
+		r.Equal("privateconnect", payload[1].Mechanism)
+		r.Equal([]string{"my-gcp-project"}, payload[1].AllowedAccounts)
+		r.Equal("private", payload[1].Visibility)
+
+		service.Endpoints[0].Visibility = "private"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&payload)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 3 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: endpointVisibilityTestConfig("nlb", "public"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "endpoint_visibility", "public"),
+				),
+			},
+			{
+				Config: endpointVisibilityTestConfig("nlb", "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "endpoint_visibility", "private"),
+					resource.TestCheckResourceAttr("skysql_service.default", "endpoints.0.visibility", "private"),
+					resource.TestCheckResourceAttr("skysql_service.default", "endpoints.#", "2"),
+				),
+			},
+			{
+				Config:      endpointVisibilityTestConfig("privateconnect", "public"),
+				ExpectError: regexp.MustCompile(`cannot be used with endpoint_mechanism`),
+			},
+		},
+	})
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func endpointsTestConfig(endpoints string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-endpoints"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		endpoints = [%s]
+	}`, endpoints)
+}
+
+const publicEndpointConfig = `
+		{
+			name      = "primary"
+			mechanism = "nlb"
+			allow_list = [
+				{
+					ip      = "192.168.0.0/24"
+					comment = "office"
+				}
+			]
+		}`
+
+const privateEndpointConfig = `
+		{
+			name             = "private"
+			mechanism        = "privateconnect"
+			allowed_accounts = ["123456789012"]
+		}`
+
+func TestServiceResourceEndpoints(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002480"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-endpoints"
+	service.Endpoints = []provisioning.Endpoint{
+		{
+			Name:       "primary",
+			Mechanism:  "nlb",
+			Visibility: "public",
+			AllowList:  []provisioning.AllowListItem{{IPAddress: "192.168.0.0/24", Comment: "office"}},
+		},
+	}
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the service is created with its first endpoint
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("nlb", payload.Mechanism)
+		r.Equal([]provisioning.AllowListItem{{IPAddress: "192.168.0.0/24", Comment: "office"}}, payload.AllowList)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: a second endpoint is added next to the first one
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPatch, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/endpoints", req.URL.Path)
+
+		var payload provisioning.PatchServiceEndpointsRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Len(payload, 2)
+		r.Equal("primary", payload[0].Name)
+		r.Equal("public", payload[0].Visibility)
+		r.Equal("private", payload[1].Name)
+		r.Equal("privateconnect", payload[1].Mechanism)
+		r.Equal("private", payload[1].Visibility)
+		r.Equal([]string{"123456789012"}, payload[1].AllowedAccounts)
+
+		// The API returns the endpoints in its own order.
+		service.Endpoints = []provisioning.Endpoint{
+			{
+				Name:            "private",
+				Mechanism:       "privateconnect",
+				Visibility:      "private",
+				AllowedAccounts: []string{"123456789012"},
+				EndpointService: "projects/skysql/regions/us-central1/serviceAttachments/" + serviceID,
+			},
+			service.Endpoints[0],
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 3 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 3: the first endpoint is removed
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPatch, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/endpoints", req.URL.Path)
+
+		var payload provisioning.PatchServiceEndpointsRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Len(payload, 1)
+		r.Equal("private", payload[0].Name)
+
+		service.Endpoints = service.Endpoints[:1]
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	sdkresource.Test(t, sdkresource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []sdkresource.TestStep{
+			{
+				Config: endpointsTestConfig(publicEndpointConfig),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.#", "1"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.0.visibility", "public"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.0.allow_list.0.ip", "192.168.0.0/24"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoint_mechanism", "nlb"),
+				),
+			},
+			{
+				Config: endpointsTestConfig(publicEndpointConfig + "," + privateEndpointConfig),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.#", "2"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.0.name", "primary"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.1.name", "private"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.1.visibility", "private"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.1.endpoint_service",
+						"projects/skysql/regions/us-central1/serviceAttachments/"+serviceID),
+				),
+			},
+			{
+				Config: endpointsTestConfig(privateEndpointConfig),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.#", "1"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoints.0.name", "private"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoint_mechanism", "privateconnect"),
+					sdkresource.TestCheckResourceAttr("skysql_service.default", "endpoint_allowed_accounts.0", "123456789012"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceUpgradeStateEndpoints(t *testing.T) {
+	ctx := context.Background()
+	r := require.New(t)
+
+	schemaType := serviceResourceSchemaV0.Type().TerraformType(ctx)
+	attrTypes := schemaType.(tftypes.Object).AttributeTypes
+	values := make(map[string]tftypes.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "dbdgf42002490")
+	values["cloud_provider"] = tftypes.NewValue(tftypes.String, "aws")
+	values["endpoint_mechanism"] = tftypes.NewValue(tftypes.String, "privateconnect")
+	values["endpoint_allowed_accounts"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "123456789012"),
+	})
+	values["endpoint_service"] = tftypes.NewValue(tftypes.String, "com.amazonaws.vpce.us-east-1.vpce-svc-0123")
+
+	upgrader := (&ServiceResource{}).UpgradeState(ctx)[2]
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: *upgrader.PriorSchema,
+			Raw:    tftypes.NewValue(schemaType, values),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: serviceResourceSchemaV0,
+			Raw:    tftypes.NewValue(schemaType, nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, resp)
+	r.False(resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state ServiceResourceModel
+	r.False(resp.State.Get(ctx, &state).HasError())
+	var endpoints []ServiceEndpointModel
+	r.False(state.Endpoints.ElementsAs(ctx, &endpoints, false).HasError())
+	r.Len(endpoints, 1)
+	r.Equal("primary", endpoints[0].Name.ValueString())
+	r.Equal("privateconnect", endpoints[0].Mechanism.ValueString())
+	r.Equal("private", endpoints[0].Visibility.ValueString())
+	r.Equal("com.amazonaws.vpce.us-east-1.vpce-svc-0123", endpoints[0].EndpointService.ValueString())
+	r.True(endpoints[0].AllowList.IsNull())
+
+	var accounts []string
+	r.False(endpoints[0].AllowedAccounts.ElementsAs(ctx, &accounts, false).HasError())
+	r.Equal([]string{"123456789012"}, accounts)
+	r.Equal(types.StringValue("privateconnect"), state.Mechanism)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceResourceFinalBackup(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002460"
+	const backupID = "bkp-final-001"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-final-backup"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Create, wait for creation and readServiceState
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Terraform Read after Create
+	expectRequest(getService)
+	// Destroy: take the final backup and wait for it before deleting
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/backups", req.URL.Path)
+
+		var payload backup.CreateBackupRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(serviceID, payload.ServiceID)
+		r.Equal(backup.TypeFull, payload.Type)
+		r.Equal("decommission", payload.Name)
+		r.Equal(int64(30), payload.RetentionDays)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(&backup.Backup{ID: backupID, ServiceID: serviceID, Type: backup.TypeFull, Status: backup.StatusScheduled})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/backups/"+backupID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&backup.Backup{ID: backupID, ServiceID: serviceID, Type: backup.TypeFull, Status: backup.StatusSucceeded})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type                = "transactional"
+					topology                    = "es-single"
+					cloud_provider              = "gcp"
+					region                      = "us-central1"
+					name                        = "test-final-backup"
+					architecture                = "amd64"
+					nodes                       = 1
+					size                        = "sky-2x8"
+					storage                     = 100
+					ssl_enabled                 = true
+					version                     = "10.6.11-6-1"
+					wait_for_creation           = true
+					wait_for_deletion           = true
+					deletion_protection         = false
+					final_backup                = true
+					final_backup_name           = "decommission"
+					final_backup_retention_days = 30
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "final_backup", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceFinalBackup_NameRequiresFinalBackup(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type      = "transactional"
+					topology          = "es-single"
+					cloud_provider    = "gcp"
+					region            = "us-central1"
+					name              = "test-final-backup"
+					architecture      = "amd64"
+					nodes             = 1
+					size              = "sky-2x8"
+					storage           = 100
+					ssl_enabled       = true
+					version           = "10.6.11-6-1"
+					final_backup_name = "decommission"
+				}`,
+				ExpectError: regexp.MustCompile(`final_backup_name requires final_backup = true`),
+			},
+		},
+	})
+}
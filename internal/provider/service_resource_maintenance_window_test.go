@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func maintenanceWindowTestConfig(startHour int) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-maintenance"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		maintenance_window = {
+			day_of_week    = "sunday"
+			start_hour     = %d
+			duration_hours = 2
+			timezone       = "Europe/Berlin"
+		}
+	}`, startHour)
+}
+
+func TestServiceResourceMaintenanceWindow(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002470"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-maintenance"
+	service.MaintenanceWindow = &provisioning.MaintenanceWindow{
+		DayOfWeek:     "sunday",
+		StartHour:     2,
+		DurationHours: 2,
+		Timezone:      "Europe/Berlin",
+	}
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the window is sent with the create request
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(service.MaintenanceWindow, payload.MaintenanceWindow)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: changing the window updates it in place
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPut, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/maintenance-window", req.URL.Path)
+
+		var payload provisioning.MaintenanceWindow
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(5, payload.StartHour)
+		r.Equal("Europe/Berlin", payload.Timezone)
+
+		service.MaintenanceWindow = &payload
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: maintenanceWindowTestConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "maintenance_window.day_of_week", "sunday"),
+					resource.TestCheckResourceAttr("skysql_service.default", "maintenance_window.start_hour", "2"),
+					resource.TestCheckResourceAttr("skysql_service.default", "maintenance_window.timezone", "Europe/Berlin"),
+				),
+			},
+			{
+				Config: maintenanceWindowTestConfig(5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "maintenance_window.start_hour", "5"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func nosqlTestConfig(topology string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = %q
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-nosql"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		nosql_enabled       = %t
+	}`, topology, enabled)
+}
+
+func TestServiceResourceNoSQL(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002550"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-nosql"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the service is created without the NoSQL interface
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.False(payload.NoSQLEnabled)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the NoSQL interface is enabled in place and its port shows up
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/nosql", req.URL.Path)
+
+		var payload provisioning.NoSQLRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.True(payload.Enabled)
+
+		service.NosqlEnabled = true
+		service.Endpoints[0].Ports = append(service.Endpoints[0].Ports,
+			provisioning.Port{Name: "nosql", Port: 27017, Purpose: provisioning.PortPurposeNoSQL})
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: nosqlTestConfig("es-single", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "nosql_enabled", "false"),
+					resource.TestCheckNoResourceAttr("skysql_service.default", "nosql_port"),
+				),
+			},
+			{
+				Config: nosqlTestConfig("es-single", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_service.default", "nosql_enabled", "true"),
+					resource.TestCheckResourceAttr("skysql_service.default", "nosql_port", "27017"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceNoSQL_UnsupportedTopology(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      nosqlTestConfig("galera", true),
+				ExpectError: regexp.MustCompile(`nosql_enabled is only supported for the es-single and es-replica`),
+			},
+		},
+	})
+}
+
+func TestValidateNoSQL(t *testing.T) {
+	r := require.New(t)
+
+	model := func(topology string, enabled bool) *ServiceResourceModel {
+		return &ServiceResourceModel{
+			Topology:     types.StringValue(topology),
+			NoSQLEnabled: types.BoolValue(enabled),
+		}
+	}
+	validate := func(plan, state *ServiceResourceModel) bool {
+		resp := &fwresource.ModifyPlanResponse{}
+		(&ServiceResource{}).validateNoSQL(plan, state, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	r.True(validate(model("xpand", true), nil))
+	r.False(validate(model("es-replica", true), nil))
+	// An existing service is only checked when nosql_enabled or the topology changes
+	r.False(validate(model("xpand", true), model("xpand", true)))
+	r.True(validate(model("xpand", true), model("xpand", false)))
+	r.True(validate(model("xpand", true), model("es-single", true)))
+}
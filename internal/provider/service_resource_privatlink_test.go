@@ -101,7 +101,7 @@ func TestServiceResourcePrivateLink(t *testing.T) {
 		r.NoError(json.NewEncoder(w).Encode(service))
 		w.WriteHeader(http.StatusCreated)
 	})
-	for i := 0; i <= 3; i++ {
+	for i := 0; i <= 4; i++ {
 		// Get service status
 		expectRequest(func(w http.ResponseWriter, req *http.Request) {
 			r.Equal(http.MethodGet, req.Method)
@@ -151,7 +151,7 @@ func TestServiceResourcePrivateLink(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	for i := 0; i <= 3; i++ {
+	for i := 0; i <= 4; i++ {
 		// Get service status
 		expectRequest(func(w http.ResponseWriter, req *http.Request) {
 			r.Equal(
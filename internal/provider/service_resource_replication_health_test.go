@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceResourceReplicationHealth_WaitForHealthy(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002510"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-replica"
+	service.ReplicationEnabled = true
+	service.PrimaryHost = "dbdgf42002480"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	getStatus := func(state string, lag *int64) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID+"/replication/status", req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&provisioning.ReplicationStatus{State: state, LagSeconds: lag})
+		}
+	}
+	lag := int64(2)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	expectRequest(getStatus(provisioning.ReplicationStateConnecting, nil))
+	// Wait until replication is running
+	expectRequest(getStatus(provisioning.ReplicationStateConnecting, nil))
+	expectRequest(getStatus(provisioning.ReplicationStateRunning, &lag))
+	// Refresh after apply
+	expectRequest(getService)
+	expectRequest(getStatus(provisioning.ReplicationStateRunning, &lag))
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type                 = "transactional"
+					topology                     = "es-single"
+					cloud_provider               = "gcp"
+					region                       = "us-central1"
+					name                         = "test-replica"
+					architecture                 = "amd64"
+					nodes                        = 1
+					size                         = "sky-2x8"
+					storage                      = 100
+					ssl_enabled                  = true
+					version                      = "10.6.11-6-1"
+					replication_enabled          = true
+					primary_host                 = "dbdgf42002480"
+					wait_for_creation            = true
+					wait_for_deletion            = true
+					wait_for_replication_healthy = true
+					deletion_protection          = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "replication_state", "running"),
+					resource.TestCheckResourceAttr("skysql_service.default", "replication_lag_seconds", "2"),
+					resource.TestCheckNoResourceAttr("skysql_service.default", "replication_last_error"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceReplicationHealth_RequiresReplication(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type                 = "transactional"
+					topology                     = "es-single"
+					cloud_provider               = "gcp"
+					region                       = "us-central1"
+					name                         = "test-replica"
+					wait_for_replication_healthy = true
+				}`,
+				ExpectError: regexp.MustCompile(`wait_for_replication_healthy requires replication_enabled = true`),
+			},
+		},
+	})
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+const testRestoreBackupID = "bkp-restore-001"
+
+func restoreTestService(serviceID string) *provisioning.Service {
+	return &provisioning.Service{
+		ID:           serviceID,
+		Name:         "test-restore",
+		Region:       "us-central1",
+		Provider:     "gcp",
+		Tier:         "power",
+		Topology:     "es-single",
+		Version:      "10.6.11-6-1",
+		Architecture: "amd64",
+		Size:         "sky-2x8",
+		Nodes:        1,
+		SSLEnabled:   true,
+		Status:       "ready",
+		CreatedOn:    int(time.Now().Unix()),
+		UpdatedOn:    int(time.Now().Unix()),
+		CreatedBy:    uuid.New().String(),
+		UpdatedBy:    uuid.New().String(),
+		Endpoints: []provisioning.Endpoint{
+			{
+				Name: "primary",
+				Ports: []provisioning.Port{
+					{Name: "readwrite", Port: 3306, Purpose: "readwrite"},
+				},
+			},
+		},
+		StorageVolume: struct {
+			Size       int    `json:"size"`
+			VolumeType string `json:"volume_type"`
+			IOPS       int    `json:"iops"`
+			Throughput int    `json:"throughput"`
+		}{Size: 100, VolumeType: "pd-ssd"},
+		IsActive:    true,
+		ServiceType: "transactional",
+	}
+}
+
+func restoreTestConfig(version string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-restore"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "%s"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		restore_from = {
+			backup_id = "%s"
+		}
+	}`, version, testRestoreBackupID)
+}
+
+func getRestoreBackupResponse(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/skybackup/v1/backups/"+testRestoreBackupID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&backup.Backup{
+			ID:        testRestoreBackupID,
+			ServiceID: "dbdgf42002400",
+			Topology:  "es-single",
+			Version:   "10.6.9-5-1",
+			Type:      backup.TypeFull,
+			Status:    backup.StatusSucceeded,
+		})
+	}
+}
+
+func TestServiceResourceRestoreFrom(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002430"
+	const restoreID = "rst-001"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+
+	getService := func(status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			s := *service
+			s.Status = status
+			json.NewEncoder(w).Encode(&s)
+		}
+	}
+
+	getRestore := func(status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/skybackup/v1/restores/"+restoreID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&backup.Restore{
+				ID:        restoreID,
+				ServiceID: serviceID,
+				BackupID:  testRestoreBackupID,
+				Status:    status,
+			})
+		}
+	}
+
+	// Provider configure: GET /versions
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Plan-time compatibility check, once for each plan terraform runs before create
+	expectRequest(getRestoreBackupResponse(t))
+	expectRequest(getRestoreBackupResponse(t))
+	expectRequest(getRestoreBackupResponse(t))
+	// Create: POST /services
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		s := *service
+		s.Status = "pending_create"
+		json.NewEncoder(w).Encode(&s)
+	})
+	// Wait for creation and readServiceState
+	expectRequest(getService("ready"))
+	expectRequest(getService("ready"))
+	// Restore: POST /restores
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/skybackup/v1/restores", req.URL.Path)
+
+		var payload backup.CreateRestoreRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(serviceID, payload.ServiceID)
+		r.Equal(testRestoreBackupID, payload.BackupID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(&backup.Restore{
+			ID:        restoreID,
+			ServiceID: serviceID,
+			BackupID:  testRestoreBackupID,
+			Status:    backup.StatusScheduled,
+		})
+	})
+	// Wait for restore: the restore runs, then the service leaves its restore phase
+	expectRequest(getRestore(backup.StatusInProgress))
+	expectRequest(getRestore(backup.StatusSucceeded))
+	expectRequest(getService("restoring"))
+	expectRequest(getService("ready"))
+	// Terraform Read after Create
+	expectRequest(getService("ready"))
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: restoreTestConfig("10.6.11-6-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_service.default", "restore_from.backup_id", testRestoreBackupID),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceRestoreFrom_IncompatibleVersion(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	// Provider configure: GET /versions
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Plan-time compatibility check
+	expectRequest(getRestoreBackupResponse(t))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      restoreTestConfig("11.4.2-1"),
+				ExpectError: regexp.MustCompile(`Incompatible backup version`),
+			},
+		},
+	})
+}
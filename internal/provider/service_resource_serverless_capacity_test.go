@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func serverlessCapacityTestConfig(topology string, serverless string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = %q
+		cloud_provider      = "aws"
+		region              = "us-east-1"
+		name                = "sls-capacity-test"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		ssl_enabled         = true
+		size                = "sky-2x8"
+		storage             = 100
+		volume_type         = "io1"
+		volume_iops         = 3000
+		serverless          = %s
+	}`, topology, serverless)
+}
+
+func TestServiceResourceServerlessCapacity(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002540"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "sls-capacity-test"
+	service.Provider = "aws"
+	service.Region = "us-east-1"
+	service.Topology = "serverless-standalone"
+	service.ServiceType = "transactional"
+	service.StorageVolume.VolumeType = "io1"
+	service.StorageVolume.IOPS = 3000
+	service.Serverless = &provisioning.ServerlessSettings{MinCapacity: 1, MaxCapacity: 4, AutoPauseAfterMinutes: toPtr(30)}
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the capacity settings are sent with the create request
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/topologies", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Topology{
+			{Name: "serverless-standalone", ServiceType: "transactional_serverless"},
+		})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(service.Serverless, payload.Serverless)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the capacity range is raised in place and auto-pause is turned off
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPut, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/serverless", req.URL.Path)
+
+		var payload provisioning.ServerlessSettings
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(provisioning.ServerlessSettings{MinCapacity: 2, MaxCapacity: 8}, payload)
+
+		service.Serverless = &payload
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: serverlessCapacityTestConfig("serverless-standalone", `{
+					min_capacity             = 1
+					max_capacity             = 4
+					auto_pause_after_minutes = 30
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "serverless.max_capacity", "4"),
+					resource.TestCheckResourceAttr("skysql_service.default", "serverless.auto_pause_after_minutes", "30"),
+				),
+			},
+			{
+				Config: serverlessCapacityTestConfig("serverless-standalone", `{
+					min_capacity = 2
+					max_capacity = 8
+				}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "serverless.min_capacity", "2"),
+					resource.TestCheckResourceAttr("skysql_service.default", "serverless.max_capacity", "8"),
+					resource.TestCheckNoResourceAttr("skysql_service.default", "serverless.auto_pause_after_minutes"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceServerlessCapacity_Validation(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: serverlessCapacityTestConfig("es-single", `{
+					min_capacity = 1
+					max_capacity = 4
+				}`),
+				ExpectError: regexp.MustCompile(`serverless is only supported for the serverless-standalone topology`),
+			},
+			{
+				Config: serverlessCapacityTestConfig("serverless-standalone", `{
+					min_capacity = 8
+					max_capacity = 4
+				}`),
+				ExpectError: regexp.MustCompile(`max_capacity \(4\) must not be lower than min_capacity \(8\)`),
+			},
+			{
+				Config: serverlessCapacityTestConfig("serverless-standalone", `{
+					min_capacity             = 1
+					max_capacity             = 4
+					auto_pause_after_minutes = 1
+				}`),
+				ExpectError: regexp.MustCompile(`value must be between 5 and\s+1440`),
+			},
+		},
+	})
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func stoppedTestConfig(waitForCreation bool) string {
+	return stoppedServiceTestConfig(waitForCreation, "sky-2x8", "")
+}
+
+func stoppedServiceTestConfig(waitForCreation bool, size string, extra string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = "es-single"
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-stopped"
+		architecture        = "amd64"
+		nodes               = 1
+		size                = %q
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = %t
+		wait_for_deletion   = true
+		deletion_protection = false
+		is_active           = false
+		%s
+	}`, size, waitForCreation, extra)
+}
+
+func TestServiceResourceCreateStopped(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002570"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-stopped"
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 2: the service is created, and stopped once it is ready
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/power", req.URL.Path)
+
+		var payload provisioning.PowerStateRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.False(payload.IsActive)
+
+		service.IsActive = false
+		service.Status = "stopping"
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		service.Status = "stopped"
+		getService(w, req)
+	})
+	// Refresh after apply; the stopped service must not show up as a change
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      stoppedTestConfig(false),
+				ExpectError: regexp.MustCompile(`is_active = false requires wait_for_creation = true`),
+			},
+			{
+				Config: stoppedTestConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_service.default", "is_active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceResourceAutoStartForUpdates(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002580"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-stopped"
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+	setPowerState := func(isActive bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodPost, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID+"/power", req.URL.Path)
+
+			var payload provisioning.PowerStateRequest
+			r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+			r.Equal(isActive, payload.IsActive)
+
+			service.IsActive = isActive
+			service.Status = "stopped"
+			if isActive {
+				service.Status = "ready"
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the service is created stopped
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	expectRequest(setPowerState(false))
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the service is started, resized and stopped again
+	expectRequest(setPowerState(true))
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/size", req.URL.Path)
+		r.True(service.IsActive)
+
+		var payload provisioning.UpdateServiceSizeRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("sky-4x16", payload.Size)
+
+		service.Size = payload.Size
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	expectRequest(setPowerState(false))
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: stoppedServiceTestConfig(true, "sky-2x8", "auto_start_for_updates = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "is_active", "false"),
+				),
+			},
+			{
+				Config: stoppedServiceTestConfig(true, "sky-4x16", "auto_start_for_updates = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "size", "sky-4x16"),
+					resource.TestCheckResourceAttr("skysql_service.default", "is_active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceUpdateChangesService(t *testing.T) {
+	r := require.New(t)
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"size":                   tftypes.String,
+		"is_active":              tftypes.Bool,
+		"auto_start_for_updates": tftypes.Bool,
+		"ignore_is_active":       tftypes.Bool,
+	}}
+	value := func(size string, isActive bool, ignoreIsActive bool) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"size":                   tftypes.NewValue(tftypes.String, size),
+			"is_active":              tftypes.NewValue(tftypes.Bool, isActive),
+			"auto_start_for_updates": tftypes.NewValue(tftypes.Bool, true),
+			"ignore_is_active":       tftypes.NewValue(tftypes.Bool, ignoreIsActive),
+		})
+	}
+	update := func(state tftypes.Value, plan tftypes.Value) fwresource.UpdateRequest {
+		return fwresource.UpdateRequest{
+			State: tfsdk.State{Raw: state},
+			Plan:  tfsdk.Plan{Raw: plan},
+		}
+	}
+
+	// Toggling ignore_is_active alone does not start a stopped service
+	r.False(serviceUpdateChangesService(update(value("sky-2x8", false, false), value("sky-2x8", false, true))))
+	r.False(serviceUpdateChangesService(update(value("sky-2x8", false, false), value("sky-2x8", true, false))))
+	r.True(serviceUpdateChangesService(update(value("sky-2x8", false, false), value("sky-4x16", false, true))))
+}
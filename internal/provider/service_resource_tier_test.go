@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func tierTestConfig(tier string, topology string, extra string) string {
+	return fmt.Sprintf(`
+	resource "skysql_service" "default" {
+		service_type        = "transactional"
+		topology            = %q
+		cloud_provider      = "gcp"
+		region              = "us-central1"
+		name                = "test-tier"
+		architecture        = "amd64"
+		size                = "sky-2x8"
+		storage             = 100
+		ssl_enabled         = true
+		version             = "10.6.11-6-1"
+		wait_for_creation   = true
+		wait_for_deletion   = true
+		deletion_protection = false
+		tier                = %q
+		%s
+	}`, topology, tier, extra)
+}
+
+func TestServiceResourceTier(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002560"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-tier"
+	service.Tier = "foundation"
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the tier is sent with the create request
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("foundation", payload.Tier)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the service is moved to the power tier in place
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/tier", req.URL.Path)
+
+		var payload provisioning.UpdateServiceTierRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal("power", payload.Tier)
+
+		service.Tier = payload.Tier
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Step 3: moving back to the foundation tier plans a replacement
+	expectRequest(getService)
+	expectRequest(getService)
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tierTestConfig("foundation", "es-single", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "tier", "foundation"),
+				),
+			},
+			{
+				Config: tierTestConfig("power", "es-single", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "id", serviceID),
+					resource.TestCheckResourceAttr("skysql_service.default", "tier", "power"),
+				),
+			},
+			{
+				Config:             tierTestConfig("foundation", "es-single", ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestServiceResourceTier_FoundationLimits(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      tierTestConfig("foundation", "es-replica", ""),
+				ExpectError: regexp.MustCompile(`topology "es-replica" is not available in the foundation tier`),
+			},
+			{
+				Config:      tierTestConfig("foundation", "es-single", "nodes = 3"),
+				ExpectError: regexp.MustCompile(`a foundation tier service has a single node`),
+			},
+			{
+				Config: tierTestConfig("foundation", "es-single", `
+					endpoint_mechanism        = "privateconnect"
+					endpoint_allowed_accounts = ["my-project-123"]`),
+				ExpectError: regexp.MustCompile(`private endpoints are not available in the foundation tier`),
+			},
+			{
+				Config:      tierTestConfig("enterprise", "es-single", ""),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestValidateTier_PlannedValue(t *testing.T) {
+	r := require.New(t)
+
+	validate := func(tier types.String) bool {
+		plan := &ServiceResourceModel{
+			Tier:       tier,
+			Topology:   types.StringValue("es-single"),
+			Nodes:      types.Int64Value(3),
+			Mechanism:  types.StringValue("nlb"),
+			Visibility: types.StringValue("public"),
+			Endpoints:  types.ListNull(serviceEndpointElementType),
+		}
+		resp := &fwresource.ModifyPlanResponse{}
+		(&ServiceResource{}).validateTier(context.Background(), plan, resp)
+		return resp.Diagnostics.HasError()
+	}
+
+	// A foundation tier kept from state is validated like a configured one
+	r.True(validate(types.StringValue(tierFoundation)))
+	r.False(validate(types.StringValue(tierPower)))
+	// The default tier of the organization is only known after apply
+	r.False(validate(types.StringUnknown()))
+}
+
+func TestServiceResourceTier_DefaultWithoutWaiting(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002521"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Name = "test-tier"
+	service.Tier = "power"
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// The tier is not configured, so the organization default reported by the create response is saved
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services", req.URL.Path)
+
+		var payload provisioning.CreateServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Empty(payload.Tier)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	// Refresh after apply
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	})
+	// Destroy without waiting
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_service" "default" {
+					service_type        = "transactional"
+					topology            = "es-single"
+					cloud_provider      = "gcp"
+					region              = "us-central1"
+					name                = "test-tier"
+					architecture        = "amd64"
+					size                = "sky-2x8"
+					storage             = 100
+					ssl_enabled         = true
+					version             = "10.6.11-6-1"
+					wait_for_creation   = false
+					wait_for_deletion   = false
+					deletion_protection = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service.default", "tier", "power"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceRestartResource{}
+var _ resource.ResourceWithConfigure = &ServiceRestartResource{}
+var _ resource.ResourceWithImportState = &ServiceRestartResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceRestartResource{}
+
+func NewServiceRestartResource() resource.Resource {
+	return &ServiceRestartResource{}
+}
+
+// ServiceRestartResource defines the resource implementation.
+type ServiceRestartResource struct {
+	client *skysql.Client
+}
+
+// ServiceRestartResourceModel describes the resource data model.
+type ServiceRestartResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	ServiceID   types.String   `tfsdk:"service_id"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	Rolling     types.Bool     `tfsdk:"rolling"`
+	LastRestart types.String   `tfsdk:"last_restart"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ServiceRestartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_restart"
+}
+
+func (r *ServiceRestartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restarts a service when any of its triggers change, for example to apply skysql_config values that require a restart at a time of your choosing. " +
+			"Creating the resource does not restart the service, and destroying it leaves the service untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the service",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service to restart",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that restart the service when they change, for example a version of the configuration",
+			},
+			"rolling": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to restart the nodes one at a time so that the service stays available. Only supported for replicated topologies. Default is false",
+			},
+			"last_restart": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time of the last restart issued by this resource, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *ServiceRestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceRestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetServiceByID(ctx, data.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service",
+			fmt.Sprintf("Unable to read service %q: %s", data.ServiceID.ValueString(), err))
+		return
+	}
+
+	// The triggers are only recorded; the service restarts when they change later on.
+	data.ID = types.StringValue(service.ID)
+	data.LastRestart = types.StringNull()
+
+	tflog.Trace(ctx, "created service restart resource", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceRestartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetServiceByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL service not found, removing service restart from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service", err.Error())
+		return
+	}
+
+	data.ServiceID = types.StringValue(service.ID)
+	if data.Rolling.IsNull() {
+		data.Rolling = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServiceRestartResourceModel
+	var state ServiceRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	if !plan.Triggers.Equal(state.Triggers) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		restartedAt, err := requestRestart(ctx, r.client, state.ID.ValueString(), plan.Rolling.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Error restarting service",
+				fmt.Sprintf("Unable to restart service %q: %s", state.ID.ValueString(), err))
+			return
+		}
+		plan.LastRestart = types.StringValue(restartedAt.Format(time.RFC3339))
+
+		// Record the restart before waiting so a failed wait does not restart the service again.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if _, err := waitForServiceReady(ctx, r.client, state.ID.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError("Error restarting service",
+				fmt.Sprintf("Service %q did not become ready after the restart: %s", state.ID.ValueString(), err))
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ServiceRestartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceRestartResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to undo; Terraform only stops tracking the triggers.
+	tflog.Trace(ctx, "deleted service restart resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *ServiceRestartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), req.ID)...)
+}
+
+func (r *ServiceRestartResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ServiceRestartResourceModel
+	var state ServiceRestartResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Triggers.Equal(state.Triggers) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_restart"), types.StringUnknown())...)
+	}
+}
+
+// restartService restarts a running service and waits until it is ready again.
+func restartService(ctx context.Context, client *skysql.Client, serviceID string, rolling bool, timeout time.Duration) (time.Time, error) {
+	restartedAt, err := requestRestart(ctx, client, serviceID, rolling)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := waitForServiceReady(ctx, client, serviceID, timeout); err != nil {
+		return time.Time{}, err
+	}
+	return restartedAt, nil
+}
+
+// requestRestart checks that the service can be restarted and requests the restart without waiting for it.
+func requestRestart(ctx context.Context, client *skysql.Client, serviceID string, rolling bool) (time.Time, error) {
+	service, err := client.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !service.IsActive {
+		return time.Time{}, errors.New("the service is stopped, start it before restarting")
+	}
+	if rolling && !Contains[string](multiNodeTopologies, service.Topology) {
+		return time.Time{}, fmt.Errorf("rolling restarts are only supported for replicated topologies, not for %q", service.Topology)
+	}
+
+	tflog.Info(ctx, "Restarting service", map[string]interface{}{
+		"id":      serviceID,
+		"rolling": rolling,
+	})
+	restartedAt := time.Now().UTC()
+	if err := client.RestartService(ctx, serviceID, rolling); err != nil {
+		return time.Time{}, err
+	}
+	return restartedAt, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func serviceRestartTestConfig(serviceID string, configVersion string, rolling bool) string {
+	return fmt.Sprintf(`
+	resource "skysql_service_restart" "this" {
+		service_id = %q
+		rolling    = %t
+		triggers = {
+			config_version = %q
+		}
+	}`, serviceID, rolling, configVersion)
+}
+
+func TestServiceRestartResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002610"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.Topology = "es-replica"
+	service.Nodes = 2
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the triggers are recorded without a restart
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: a changed trigger restarts the service node by node
+	expectRequest(getService)
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID+"/restart", req.URL.Path)
+
+		var payload provisioning.RestartServiceRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.True(payload.Rolling)
+
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService)
+	// Refresh after apply
+	expectRequest(getService)
+	// Import reads the service by its ID
+	expectRequest(getService)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: serviceRestartTestConfig(serviceID, "1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service_restart.this", "id", serviceID),
+					resource.TestCheckNoResourceAttr("skysql_service_restart.this", "last_restart"),
+				),
+			},
+			{
+				Config: serviceRestartTestConfig(serviceID, "2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_service_restart.this", "triggers.config_version", "2"),
+					resource.TestCheckResourceAttrSet("skysql_service_restart.this", "last_restart"),
+				),
+			},
+			{
+				ResourceName:            "skysql_service_restart.this",
+				ImportState:             true,
+				ImportStateId:           serviceID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "rolling", "last_restart"},
+			},
+		},
+	})
+}
+
+func TestServiceRestartResource_RollingSingleNode(t *testing.T) {
+	configureOnce.Reset()
+
+	const serviceID = "dbdgf42002611"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	service := restoreTestService(serviceID)
+	service.IsActive = true
+
+	getService := func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// Step 1: the triggers are recorded without a restart
+	expectRequest(getService)
+	// Refresh after apply and before the step 2 plan
+	expectRequest(getService)
+	expectRequest(getService)
+	// Step 2: the es-single service cannot be restarted node by node
+	expectRequest(getService)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: serviceRestartTestConfig(serviceID, "1", true),
+			},
+			{
+				Config:      serviceRestartTestConfig(serviceID, "2", true),
+				ExpectError: regexp.MustCompile(`rolling restarts are only\s+supported for replicated topologies`),
+			},
+		},
+	})
+}
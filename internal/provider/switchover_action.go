@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &SwitchoverAction{}
+var _ action.ActionWithConfigure = &SwitchoverAction{}
+
+func NewSwitchoverAction() action.Action {
+	return &SwitchoverAction{}
+}
+
+// SwitchoverAction defines the action implementation.
+type SwitchoverAction struct {
+	client *skysql.Client
+}
+
+// SwitchoverActionModel describes the action data model.
+type SwitchoverActionModel struct {
+	ServiceID        types.String `tfsdk:"service_id"`
+	PrimaryServiceID types.String `tfsdk:"primary_service_id"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func (a *SwitchoverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switchover"
+}
+
+func (a *SwitchoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Performs a planned switchover: the replica service becomes the primary and the former primary replicates from it. " +
+			"Waits until both services are ready.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the replica service that becomes the primary",
+			},
+			"primary_service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the current primary service, which becomes a replica",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for both services to be ready, as a duration such as 30m. Default is 60m",
+			},
+		},
+	}
+}
+
+func (a *SwitchoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *SwitchoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SwitchoverActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	primaryServiceID := data.PrimaryServiceID.ValueString()
+
+	if serviceID == primaryServiceID {
+		resp.Diagnostics.AddAttributeError(path.Root("primary_service_id"),
+			"Invalid configuration",
+			"primary_service_id must be different from service_id")
+		return
+	}
+
+	timeout, err := actionTimeout(data.Timeout, defaultUpdateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid configuration", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Switching the primary from service %s to service %s", primaryServiceID, serviceID),
+	})
+
+	err = a.client.PromoteService(ctx, serviceID, &provisioning.ReplicationPromotionRequest{
+		Mode:             provisioning.PromotionModeSwitchover,
+		PrimaryServiceID: primaryServiceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error switching over",
+			fmt.Sprintf("Unable to switch over to service %q: %s", serviceID, err))
+		return
+	}
+
+	for _, id := range []string{serviceID, primaryServiceID} {
+		if _, err := waitForServiceReady(ctx, a.client, id, timeout); err != nil {
+			resp.Diagnostics.AddError("Error switching over",
+				fmt.Sprintf("Service %q did not return to ready state after the switchover: %s", id, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "invoked switchover action", map[string]interface{}{
+		"service_id":         serviceID,
+		"primary_service_id": primaryServiceID,
+	})
+}
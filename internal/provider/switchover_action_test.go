@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwitchoverAction(t *testing.T) {
+	configureOnce.Reset()
+
+	const replicaID = "dbdgf42002630"
+	const primaryID = "dbdgf42002631"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	getService := func(serviceID string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/services/"+serviceID, req.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(restoreTestService(serviceID))
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	// The replica takes over and both services are ready again
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/services/"+replicaID+"/replication/promote", req.URL.Path)
+
+		var payload provisioning.ReplicationPromotionRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(provisioning.PromotionModeSwitchover, payload.Mode)
+		r.Equal(primaryID, payload.PrimaryServiceID)
+
+		w.WriteHeader(http.StatusOK)
+	})
+	expectRequest(getService(replicaID))
+	expectRequest(getService(primaryID))
+
+	progress, diags := invokeTestAction(t, "skysql_switchover", map[string]tftypes.Value{
+		"service_id":         tftypes.NewValue(tftypes.String, replicaID),
+		"primary_service_id": tftypes.NewValue(tftypes.String, primaryID),
+	})
+	require.Empty(t, diags)
+	require.Equal(t, []string{"Switching the primary from service " + primaryID + " to service " + replicaID}, progress)
+
+	// A service cannot switch over to itself
+	_, diags = invokeTestAction(t, "skysql_switchover", map[string]tftypes.Value{
+		"service_id":         tftypes.NewValue(tftypes.String, replicaID),
+		"primary_service_id": tftypes.NewValue(tftypes.String, replicaID),
+	})
+	require.Len(t, diags, 1)
+	require.Equal(t, "primary_service_id must be different from service_id", diags[0].Detail)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"github.com/asaskevich/govalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"net"
+	"strings"
+	"time"
 )
 
 type allowListIPValidator struct{}
@@ -39,6 +42,33 @@ func (v allowListIPValidator) ValidateString(ctx context.Context, req validator.
 	}
 }
 
+type rfc3339Validator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be a timestamp in RFC 3339 format, for example 2024-01-02T15:04:05Z"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a timestamp in RFC 3339 format, for example `2024-01-02T15:04:05Z`"
+}
+
+// ValidateString Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid time format",
+			"The value must be a timestamp in RFC 3339 format, for example 2024-01-02T15:04:05Z: "+err.Error(),
+		)
+	}
+}
+
 func isValidCIDR(cidr string) bool {
 	_, ipnet, err := net.ParseCIDR(cidr)
 	if err != nil {
@@ -65,3 +95,28 @@ func Contains[T comparable](slice []T, value T) bool {
 }
 
 func toPtr[t any](u t) *t { return &u }
+
+// stringValueOrNull returns a null string for the empty string the API returns for unset values.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// ipToCIDR turns a single IP address into a /32 CIDR and leaves CIDRs unchanged.
+func ipToCIDR(ip string) string {
+	if !govalidator.IsCIDR(ip) && govalidator.IsIP(ip) {
+		return ip + "/32"
+	}
+	return ip
+}
+
+// serverVersionSeries returns the major.minor part of a server version, e.g. 10.6 for 10.6.11-6-1.
+func serverVersionSeries(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+)
+
+var rxAWSVPCID = regexp.MustCompile(`^vpc-[0-9a-f]{8,17}$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VPCPeeringResource{}
+var _ resource.ResourceWithConfigure = &VPCPeeringResource{}
+var _ resource.ResourceWithImportState = &VPCPeeringResource{}
+var _ resource.ResourceWithModifyPlan = &VPCPeeringResource{}
+
+func NewVPCPeeringResource() resource.Resource {
+	return &VPCPeeringResource{}
+}
+
+// VPCPeeringResource defines the resource implementation.
+type VPCPeeringResource struct {
+	client *skysql.Client
+}
+
+// VPCPeeringResourceModel describes the resource data model.
+type VPCPeeringResourceModel struct {
+	ID                    types.String   `tfsdk:"id"`
+	Name                  types.String   `tfsdk:"name"`
+	CloudProvider         types.String   `tfsdk:"cloud_provider"`
+	Region                types.String   `tfsdk:"region"`
+	PeerVPCID             types.String   `tfsdk:"peer_vpc_id"`
+	PeerNetwork           types.String   `tfsdk:"peer_network"`
+	PeerAccountID         types.String   `tfsdk:"peer_account_id"`
+	PeerProjectID         types.String   `tfsdk:"peer_project_id"`
+	PeerCIDR              types.String   `tfsdk:"peer_cidr"`
+	Status                types.String   `tfsdk:"status"`
+	StatusMessage         types.String   `tfsdk:"status_message"`
+	PeeringConnectionID   types.String   `tfsdk:"aws_peering_connection_id"`
+	SkySQLAccountID       types.String   `tfsdk:"skysql_account_id"`
+	SkySQLVPCID           types.String   `tfsdk:"skysql_vpc_id"`
+	SkySQLProjectID       types.String   `tfsdk:"skysql_project_id"`
+	SkySQLNetwork         types.String   `tfsdk:"skysql_network"`
+	SkySQLNetworkSelfLink types.String   `tfsdk:"skysql_network_self_link"`
+	SkySQLCIDR            types.String   `tfsdk:"skysql_cidr"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *VPCPeeringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_peering"
+}
+
+func (r *VPCPeeringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Peers the SkySQL network of a region with a VPC on AWS or a VPC network on GCP. " +
+			"The provider waits for the peering to be ready to be accepted on your side. " +
+			"On AWS, accept it with aws_vpc_peering_connection_accepter. On GCP, create the other half with google_compute_network_peering. " +
+			"Every argument forces a new peering.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the VPC peering",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the VPC peering",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				Required:    true,
+				Description: "The cloud provider of the peered network. Valid values are: aws or gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "The region of the SkySQL network to peer with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_vpc_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the AWS VPC to peer with, for example vpc-0123456789abcdef0. Required when cloud_provider is aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_network": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the GCP VPC network to peer with. Required when cloud_provider is gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The 12-digit ID of the AWS account that owns the peer VPC. Required when cloud_provider is aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the GCP project that owns the peer network. Required when cloud_provider is gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer_cidr": schema.StringAttribute{
+				Required:    true,
+				Description: "The CIDR block of the peer network, for example 10.0.0.0/16. It must not overlap with skysql_cidr",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the VPC peering. Possible values are: pending, pending_acceptance, active or failed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "Details about the status of the VPC peering, if any",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_peering_connection_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the AWS peering connection, to use as vpc_peering_connection_id of an aws_vpc_peering_connection_accepter. Null when cloud_provider is not aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_account_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the AWS account of the SkySQL network. Null when cloud_provider is not aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_vpc_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the SkySQL VPC. Null when cloud_provider is not aws",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_project_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the GCP project of the SkySQL network. Null when cloud_provider is not gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_network": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the SkySQL VPC network. Null when cloud_provider is not gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_network_self_link": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SkySQL VPC network, to use as peer_network of a google_compute_network_peering. Null when cloud_provider is not gcp",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skysql_cidr": schema.StringAttribute{
+				Computed:    true,
+				Description: "The CIDR block of the SkySQL network, to route through the peering",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *VPCPeeringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*skysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *skysql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VPCPeeringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := r.client.CreateVPCPeering(ctx, &provisioning.CreateVPCPeeringRequest{
+		Name:          data.Name.ValueString(),
+		Provider:      data.CloudProvider.ValueString(),
+		Region:        data.Region.ValueString(),
+		PeerVPCID:     data.PeerVPCID.ValueString(),
+		PeerNetwork:   data.PeerNetwork.ValueString(),
+		PeerAccountID: data.PeerAccountID.ValueString(),
+		PeerProjectID: data.PeerProjectID.ValueString(),
+		PeerCIDR:      data.PeerCIDR.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VPC peering",
+			fmt.Sprintf("Unable to create VPC peering %q: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(peering.ID)
+	vpcPeeringToState(peering, &data)
+
+	tflog.Trace(ctx, "created VPC peering resource", map[string]interface{}{
+		"id": peering.ID,
+	})
+
+	// Save the ID so a failed wait does not leave the peering outside of the state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err = waitForVPCPeering(ctx, r.client, peering.ID, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating VPC peering",
+			fmt.Sprintf("VPC peering %q did not become ready: %s", data.ID.ValueString(), err))
+		return
+	}
+	vpcPeeringToState(peering, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VPCPeeringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peering, err := r.client.GetVPCPeeringByID(ctx, data.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			tflog.Warn(ctx, "SkySQL VPC peering not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading VPC peering", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(peering.Name)
+	data.CloudProvider = types.StringValue(peering.Provider)
+	data.Region = types.StringValue(peering.Region)
+	data.PeerVPCID = stringValueOrNull(peering.PeerVPCID)
+	data.PeerNetwork = stringValueOrNull(peering.PeerNetwork)
+	data.PeerAccountID = stringValueOrNull(peering.PeerAccountID)
+	data.PeerProjectID = stringValueOrNull(peering.PeerProjectID)
+	data.PeerCIDR = types.StringValue(peering.PeerCIDR)
+	vpcPeeringToState(peering, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only records new timeouts, since every other argument forces a new peering.
+func (r *VPCPeeringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VPCPeeringResourceModel
+	var state VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VPCPeeringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VPCPeeringResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVPCPeering(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, skysql.ErrorServiceNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting VPC peering",
+			fmt.Sprintf("Unable to delete VPC peering %q: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	err = sdkresource.RetryContext(ctx, deleteTimeout, func() *sdkresource.RetryError {
+		_, err := r.client.GetVPCPeeringByID(ctx, state.ID.ValueString())
+		if err != nil {
+			if errors.Is(err, skysql.ErrorServiceNotFound) {
+				return nil
+			}
+			return sdkresource.NonRetryableError(err)
+		}
+		return sdkresource.RetryableError(errors.New("VPC peering is still being deleted"))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting VPC peering",
+			fmt.Sprintf("VPC peering %q was not deleted: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted VPC peering resource", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+func (r *VPCPeeringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *VPCPeeringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan VPCPeeringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PeerCIDR.IsUnknown() && !isValidCIDR(plan.PeerCIDR.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("peer_cidr"),
+			"Invalid configuration",
+			fmt.Sprintf("%q is not a CIDR block, for example 10.0.0.0/16", plan.PeerCIDR.ValueString()))
+	}
+
+	if plan.CloudProvider.IsUnknown() {
+		return
+	}
+	cloudProvider := plan.CloudProvider.ValueString()
+
+	// The attributes each cloud provider requires, and the attributes it does not support.
+	required := map[string]types.String{"peer_vpc_id": plan.PeerVPCID, "peer_account_id": plan.PeerAccountID}
+	unsupported := map[string]types.String{"peer_network": plan.PeerNetwork, "peer_project_id": plan.PeerProjectID}
+	if cloudProvider == "gcp" {
+		required, unsupported = unsupported, required
+	}
+	for _, name := range []string{"peer_vpc_id", "peer_account_id", "peer_network", "peer_project_id"} {
+		if value, ok := required[name]; ok && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid configuration",
+				fmt.Sprintf("%s is required when cloud_provider is %s", name, cloudProvider))
+		}
+		if value, ok := unsupported[name]; ok && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid configuration",
+				fmt.Sprintf("%s cannot be used when cloud_provider is %s", name, cloudProvider))
+		}
+	}
+
+	if !plan.PeerVPCID.IsUnknown() && !plan.PeerVPCID.IsNull() && !rxAWSVPCID.MatchString(plan.PeerVPCID.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("peer_vpc_id"),
+			"Invalid configuration",
+			fmt.Sprintf("%q is not an AWS VPC ID, for example vpc-0123456789abcdef0", plan.PeerVPCID.ValueString()))
+	}
+
+	for name, account := range map[string]types.String{"peer_account_id": plan.PeerAccountID, "peer_project_id": plan.PeerProjectID} {
+		if account.IsUnknown() || account.IsNull() {
+			continue
+		}
+		if err := validateAllowedAccount(cloudProvider, account.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid configuration", err.Error())
+		}
+	}
+}
+
+// waitForVPCPeering polls a VPC peering until it can be accepted on the peer side, fails or the timeout elapses.
+func waitForVPCPeering(ctx context.Context, client *skysql.Client, peeringID string, timeout time.Duration) (*provisioning.VPCPeering, error) {
+	var result *provisioning.VPCPeering
+	err := sdkresource.RetryContext(ctx, timeout, func() *sdkresource.RetryError {
+		peering, err := client.GetVPCPeeringByID(ctx, peeringID)
+		if err != nil {
+			return sdkresource.NonRetryableError(fmt.Errorf("error retrieving VPC peering details: %v", err))
+		}
+
+		switch peering.Status {
+		case provisioning.VPCPeeringStatusPendingAcceptance, provisioning.VPCPeeringStatusActive:
+			result = peering
+			return nil
+		case provisioning.VPCPeeringStatusFailed:
+			return sdkresource.NonRetryableError(fmt.Errorf("VPC peering failed: %s", peering.StatusMessage))
+		}
+
+		return sdkresource.RetryableError(fmt.Errorf("expected VPC peering to be pending_acceptance or active but was %s", peering.Status))
+	})
+
+	return result, err
+}
+
+func vpcPeeringToState(peering *provisioning.VPCPeering, data *VPCPeeringResourceModel) {
+	data.Status = types.StringValue(peering.Status)
+	data.StatusMessage = stringValueOrNull(peering.StatusMessage)
+	data.PeeringConnectionID = stringValueOrNull(peering.PeeringConnectionID)
+	data.SkySQLAccountID = stringValueOrNull(peering.SkySQLAccountID)
+	data.SkySQLVPCID = stringValueOrNull(peering.SkySQLVPCID)
+	data.SkySQLProjectID = stringValueOrNull(peering.SkySQLProjectID)
+	data.SkySQLNetwork = stringValueOrNull(peering.SkySQLNetwork)
+	data.SkySQLNetworkSelfLink = types.StringNull()
+	if peering.SkySQLProjectID != "" && peering.SkySQLNetwork != "" {
+		data.SkySQLNetworkSelfLink = types.StringValue(
+			fmt.Sprintf("projects/%s/global/networks/%s", peering.SkySQLProjectID, peering.SkySQLNetwork))
+	}
+	data.SkySQLCIDR = stringValueOrNull(peering.SkySQLCIDR)
+}
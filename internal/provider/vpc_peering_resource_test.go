@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVPCPeeringResource(t *testing.T) {
+	configureOnce.Reset()
+
+	const peeringID = "pcx-skysql-0001"
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	peering := &provisioning.VPCPeering{
+		ID:              peeringID,
+		Name:            "analytics",
+		Provider:        "aws",
+		Region:          "us-east-2",
+		PeerVPCID:       "vpc-0123456789abcdef0",
+		PeerAccountID:   "123456789012",
+		PeerCIDR:        "10.0.0.0/16",
+		Status:          provisioning.VPCPeeringStatusPending,
+		SkySQLAccountID: "210987654321",
+		SkySQLVPCID:     "vpc-0fedcba9876543210",
+		SkySQLCIDR:      "172.20.0.0/16",
+	}
+
+	getPeering := func(status string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			r := require.New(t)
+			r.Equal(http.MethodGet, req.Method)
+			r.Equal("/provisioning/v1/vpc-peerings/"+peeringID, req.URL.Path)
+			peering.Status = status
+			if status != provisioning.VPCPeeringStatusPending {
+				peering.PeeringConnectionID = "pcx-0123456789abcdef0"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(peering)
+		}
+	}
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodPost, req.Method)
+		r.Equal("/provisioning/v1/vpc-peerings", req.URL.Path)
+
+		var payload provisioning.CreateVPCPeeringRequest
+		r.NoError(json.NewDecoder(req.Body).Decode(&payload))
+		r.Equal(provisioning.CreateVPCPeeringRequest{
+			Name:          "analytics",
+			Provider:      "aws",
+			Region:        "us-east-2",
+			PeerVPCID:     "vpc-0123456789abcdef0",
+			PeerAccountID: "123456789012",
+			PeerCIDR:      "10.0.0.0/16",
+		}, payload)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(peering)
+	})
+	expectRequest(getPeering(provisioning.VPCPeeringStatusPending))
+	expectRequest(getPeering(provisioning.VPCPeeringStatusPendingAcceptance))
+	// Refresh after apply
+	expectRequest(getPeering(provisioning.VPCPeeringStatusPendingAcceptance))
+	// Import reads the peering by its ID
+	expectRequest(getPeering(provisioning.VPCPeeringStatusActive))
+	// Destroy and wait for deletion
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodDelete, req.Method)
+		r.Equal("/provisioning/v1/vpc-peerings/"+peeringID, req.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/vpc-peerings/"+peeringID, req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&skysql.ErrorResponse{Code: http.StatusNotFound})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_vpc_peering" "analytics" {
+					name            = "analytics"
+					cloud_provider  = "aws"
+					region          = "us-east-2"
+					peer_vpc_id     = "vpc-0123456789abcdef0"
+					peer_account_id = "123456789012"
+					peer_cidr       = "10.0.0.0/16"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("skysql_vpc_peering.analytics", "id", peeringID),
+					resource.TestCheckResourceAttr("skysql_vpc_peering.analytics", "status", "pending_acceptance"),
+					resource.TestCheckResourceAttr("skysql_vpc_peering.analytics", "aws_peering_connection_id", "pcx-0123456789abcdef0"),
+					resource.TestCheckResourceAttr("skysql_vpc_peering.analytics", "skysql_cidr", "172.20.0.0/16"),
+					resource.TestCheckNoResourceAttr("skysql_vpc_peering.analytics", "skysql_network_self_link"),
+				),
+			},
+			{
+				ResourceName:            "skysql_vpc_peering.analytics",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+	})
+}
+
+func TestVPCPeeringResource_InvalidConfiguration(t *testing.T) {
+	configureOnce.Reset()
+
+	testUrl, expectRequest, close := mockSkySQLAPI(t)
+	defer close()
+	os.Setenv("TF_SKYSQL_API_KEY", "[api-key]")
+	os.Setenv("TF_SKYSQL_API_BASE_URL", testUrl)
+
+	expectRequest(func(w http.ResponseWriter, req *http.Request) {
+		r := require.New(t)
+		r.Equal(http.MethodGet, req.Method)
+		r.Equal("/provisioning/v1/versions", req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]provisioning.Version{})
+	})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest: true,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"skysql": providerserver.NewProtocol6WithError(New("")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "skysql_vpc_peering" "analytics" {
+					name            = "analytics"
+					cloud_provider  = "gcp"
+					region          = "us-central1"
+					peer_vpc_id     = "vpc-0123456789abcdef0"
+					peer_project_id = "my-project-123"
+					peer_cidr       = "10.0.0.0/16"
+				}`,
+				ExpectError: regexp.MustCompile(`peer_network is required when cloud_provider is gcp`),
+			},
+		},
+	})
+}
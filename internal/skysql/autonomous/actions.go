@@ -8,6 +8,7 @@ import (
 const AutoScaleNodesHorizontalActionGroup = "autoScaleNodesHorizontal"
 const AutoScaleNodesVerticalActionGroup = "autoScaleNodesVertical"
 const AutoScaleDiskActionGroup = "autoScaleDisk"
+const PowerScheduleActionGroup = "powerSchedule"
 
 // AutoScaleNodesVerticalActionParams is an autoscale action that scales nodes vertically
 type AutoScaleNodesVerticalActionParams struct {
@@ -26,6 +27,13 @@ type AutoScaleDiskActionParams struct {
 	MaxStorageSizeGBs int64 `json:"max_storage_size_gbs"`
 }
 
+// PowerScheduleActionParams is a scheduled action that stops and starts a service
+type PowerScheduleActionParams struct {
+	StopSchedule  string `json:"stop_schedule"`
+	StartSchedule string `json:"start_schedule"`
+	Timezone      string `json:"timezone"`
+}
+
 type SetAutonomousActionsRequest struct {
 	ServiceID   string `json:"service_id"`
 	ServiceName string `json:"service_name"`
@@ -94,6 +102,26 @@ func NewAutoScaleNodesVerticalAction(minNodeSize string, maxNodeSize string) Aut
 	return action
 }
 
+func NewPowerScheduleAction(stopSchedule string, startSchedule string, timezone string, enabled bool) AutoScaleAction {
+	action := AutoScaleAction{
+		Group:   PowerScheduleActionGroup,
+		Enabled: enabled,
+	}
+	rawMessage, err := json.Marshal(&PowerScheduleActionParams{
+		StopSchedule:  stopSchedule,
+		StartSchedule: startSchedule,
+		Timezone:      timezone,
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+	action.Params = rawMessage
+
+	return action
+}
+
 type ActionResponse struct {
 	Group       string          `json:"group"`
 	Enabled     bool            `json:"enabled"`
@@ -0,0 +1,47 @@
+package backup
+
+import "time"
+
+const (
+	StatusScheduled  = "scheduled"
+	StatusInProgress = "in_progress"
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+)
+
+const (
+	TypeFull        = "full"
+	TypeIncremental = "incremental"
+	TypeSnapshot    = "snapshot"
+)
+
+// Backup is a single backup of a service.
+type Backup struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name,omitempty"`
+	ServiceID   string     `json:"service_id"`
+	ServiceName string     `json:"service_name,omitempty"`
+	Topology    string     `json:"topology,omitempty"`
+	Version     string     `json:"version,omitempty"`
+	Type        string     `json:"backup_type"`
+	Method      string     `json:"method,omitempty"`
+	Status      string     `json:"status"`
+	Size        int64      `json:"backup_size"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// CreateBackupRequest is the request body for an on-demand backup, POST /backups.
+type CreateBackupRequest struct {
+	ServiceID string `json:"service_id"`
+	Type      string `json:"backup_type"`
+	Name      string `json:"name,omitempty"`
+	// RetentionDays overrides the retention of the backup. Zero keeps the default retention.
+	RetentionDays int64 `json:"retention_days,omitempty"`
+}
+
+// ListBackupsResponse is the response body of GET /backups.
+type ListBackupsResponse struct {
+	Backups []Backup `json:"backups"`
+}
@@ -0,0 +1,25 @@
+package backup
+
+import "time"
+
+// Restore is a restore of a backup into a service. Its Status takes the same
+// values as a backup status.
+type Restore struct {
+	ID        string     `json:"id"`
+	ServiceID string     `json:"service_id"`
+	BackupID  string     `json:"backup_id"`
+	Status    string     `json:"status"`
+	StartTime *time.Time `json:"start_time,omitempty"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// CreateRestoreRequest is the request body for POST /restores. Either BackupID
+// or SourceServiceID with PointInTime is set: the latter restores the source
+// service's backups and binary logs up to PointInTime, in RFC 3339 format.
+type CreateRestoreRequest struct {
+	ServiceID       string `json:"service_id"`
+	BackupID        string `json:"backup_id,omitempty"`
+	SourceServiceID string `json:"source_service_id,omitempty"`
+	PointInTime     string `json:"point_in_time,omitempty"`
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 
 	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/autonomous"
+	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/backup"
 	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/organization"
 	"github.com/skysqlinc/terraform-provider-skysql/internal/skysql/provisioning"
 )
@@ -313,23 +314,95 @@ func (c *Client) SetServicePowerState(ctx context.Context, serviceID string, isA
 	})
 }
 
+// SetServiceNoSQL enables or disables the NoSQL interface of a service.
+func (c *Client) SetServiceNoSQL(ctx context.Context, serviceID string, enabled bool) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(&provisioning.NoSQLRequest{Enabled: enabled}).
+			SetError(&ErrorResponse{}).
+			Post("/provisioning/v1/services/" + serviceID + "/nosql")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+// RestartService restarts the database nodes of a service, one node at a time when rolling is set.
+func (c *Client) RestartService(ctx context.Context, serviceID string, rolling bool) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(&provisioning.RestartServiceRequest{Rolling: rolling}).
+			SetError(&ErrorResponse{}).
+			Post("/provisioning/v1/services/" + serviceID + "/restart")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+func (c *Client) PromoteService(ctx context.Context, serviceID string, req *provisioning.ReplicationPromotionRequest) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(req).
+			SetError(&ErrorResponse{}).
+			Post("/provisioning/v1/services/" + serviceID + "/replication/promote")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+func (c *Client) GetServiceReplicationStatus(ctx context.Context, serviceID string) (*provisioning.ReplicationStatus, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(provisioning.ReplicationStatus{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Get("/provisioning/v1/services/" + serviceID + "/replication/status")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*provisioning.ReplicationStatus), nil
+}
+
+// ModifyServiceEndpoints replaces every endpoint of the service with the given endpoints.
+// Endpoints are matched by name; endpoints that are not in the list are removed.
 func (c *Client) ModifyServiceEndpoints(
 	ctx context.Context,
 	serviceID string,
-	mechanism string,
-	allowedAccounts []string,
-	visibility string,
-) (*provisioning.ServiceEndpoint, error) {
-	var result *provisioning.ServiceEndpoint
+	endpoints []provisioning.ServiceEndpoint,
+) ([]provisioning.ServiceEndpoint, error) {
+	var result []provisioning.ServiceEndpoint
 	err := c.doWithPendingRetry(ctx, func() error {
+		request := provisioning.PatchServiceEndpointsRequest(endpoints)
 		resp, err := c.HTTPClient.R().
 			SetHeader("Accept", "application/json").
 			SetContext(ctx).
-			SetBody(&provisioning.PatchServiceEndpointsRequest{
-				{Mechanism: mechanism,
-					AllowedAccounts: allowedAccounts,
-					Visibility:      visibility},
-			}).
+			SetBody(&request).
 			SetResult(provisioning.PatchServiceEndpointsResponse{}).
 			SetError(&ErrorResponse{}).
 			Patch("/provisioning/v1/services/" + serviceID + "/endpoints")
@@ -339,11 +412,7 @@ func (c *Client) ModifyServiceEndpoints(
 		if resp.IsError() {
 			return handleError(resp)
 		}
-		response := *resp.Result().(*provisioning.PatchServiceEndpointsResponse)
-		if response == nil {
-			response = make(provisioning.PatchServiceEndpointsResponse, 0)
-		}
-		result = &response[0]
+		result = *resp.Result().(*provisioning.PatchServiceEndpointsResponse)
 		return nil
 	})
 
@@ -369,6 +438,26 @@ func (c *Client) ModifyServiceSize(ctx context.Context, serviceID string, size s
 	})
 }
 
+// ModifyServiceTier moves a service to another tier.
+func (c *Client) ModifyServiceTier(ctx context.Context, serviceID string, tier string) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(&provisioning.UpdateServiceTierRequest{Tier: tier}).
+			SetError(&ErrorResponse{}).
+			Post("/provisioning/v1/services/" + serviceID + "/tier")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
 func (c *Client) ModifyServiceNodeNumber(ctx context.Context, serviceID string, req *provisioning.UpdateServiceNodesNumberRequest) error {
 	return c.doWithPendingRetry(ctx, func() error {
 		resp, err := c.HTTPClient.R().
@@ -426,6 +515,83 @@ func (c *Client) UpdateServiceTags(ctx context.Context, serviceID string, tags m
 	})
 }
 
+func (c *Client) UpdateServiceMaintenanceWindow(ctx context.Context, serviceID string, window *provisioning.MaintenanceWindow) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(window).
+			SetError(&ErrorResponse{}).
+			Put("/provisioning/v1/services/" + serviceID + "/maintenance-window")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+// DeleteServiceMaintenanceWindow reverts the service to the default maintenance window.
+func (c *Client) DeleteServiceMaintenanceWindow(ctx context.Context, serviceID string) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetError(&ErrorResponse{}).
+			Delete("/provisioning/v1/services/" + serviceID + "/maintenance-window")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+// UpdateServiceServerless changes the capacity settings of a serverless service.
+func (c *Client) UpdateServiceServerless(ctx context.Context, serviceID string, settings *provisioning.ServerlessSettings) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetBody(settings).
+			SetError(&ErrorResponse{}).
+			Put("/provisioning/v1/services/" + serviceID + "/serverless")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+// DeleteServiceServerless reverts a serverless service to the default capacity settings.
+func (c *Client) DeleteServiceServerless(ctx context.Context, serviceID string) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetError(&ErrorResponse{}).
+			Delete("/provisioning/v1/services/" + serviceID + "/serverless")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
 func (c *Client) SetAutonomousActions(
 	ctx context.Context,
 	value autonomous.SetAutonomousActionsRequest,
@@ -672,3 +838,249 @@ func (c *Client) UnsetConfigValue(ctx context.Context, configID string, variable
 	}
 	return nil
 }
+
+func (c *Client) CreateBackup(ctx context.Context, req *backup.CreateBackupRequest) (*backup.Backup, error) {
+	var result *backup.Backup
+	err := c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetResult(backup.Backup{}).
+			SetError(&ErrorResponse{}).
+			SetContext(ctx).
+			SetBody(req).
+			Post("/skybackup/v1/backups")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+		result = resp.Result().(*backup.Backup)
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *Client) GetBackupByID(ctx context.Context, backupID string) (*backup.Backup, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(backup.Backup{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Get("/skybackup/v1/backups/" + backupID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*backup.Backup), nil
+}
+
+func (c *Client) GetBackups(ctx context.Context, serviceID string) ([]backup.Backup, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(backup.ListBackupsResponse{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		SetQueryParam("service_id", serviceID).
+		Get("/skybackup/v1/backups")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	response := resp.Result().(*backup.ListBackupsResponse)
+	if response.Backups == nil {
+		response.Backups = make([]backup.Backup, 0)
+	}
+	return response.Backups, nil
+}
+
+func (c *Client) DeleteBackup(ctx context.Context, backupID string) error {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Delete("/skybackup/v1/backups/" + backupID)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return handleError(resp)
+	}
+	return nil
+}
+
+func (c *Client) CreateRestore(ctx context.Context, req *backup.CreateRestoreRequest) (*backup.Restore, error) {
+	var result *backup.Restore
+	err := c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetResult(backup.Restore{}).
+			SetError(&ErrorResponse{}).
+			SetContext(ctx).
+			SetBody(req).
+			Post("/skybackup/v1/restores")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+		result = resp.Result().(*backup.Restore)
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *Client) GetRestoreByID(ctx context.Context, restoreID string) (*backup.Restore, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(backup.Restore{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Get("/skybackup/v1/restores/" + restoreID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*backup.Restore), nil
+}
+
+func (c *Client) ConfigureExternalReplication(ctx context.Context, serviceID string, req *provisioning.ExternalReplicationRequest) (*provisioning.ExternalReplication, error) {
+	var result *provisioning.ExternalReplication
+	err := c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetResult(provisioning.ExternalReplication{}).
+			SetError(&ErrorResponse{}).
+			SetContext(ctx).
+			SetBody(req).
+			Put("/provisioning/v1/services/" + serviceID + "/replication/external")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+		result = resp.Result().(*provisioning.ExternalReplication)
+		return nil
+	})
+
+	return result, err
+}
+
+func (c *Client) GetExternalReplication(ctx context.Context, serviceID string) (*provisioning.ExternalReplication, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(provisioning.ExternalReplication{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Get("/provisioning/v1/services/" + serviceID + "/replication/external")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*provisioning.ExternalReplication), nil
+}
+
+func (c *Client) StartExternalReplication(ctx context.Context, serviceID string) error {
+	return c.postExternalReplicationAction(ctx, serviceID, "start")
+}
+
+func (c *Client) StopExternalReplication(ctx context.Context, serviceID string) error {
+	return c.postExternalReplicationAction(ctx, serviceID, "stop")
+}
+
+func (c *Client) postExternalReplicationAction(ctx context.Context, serviceID string, action string) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetError(&ErrorResponse{}).
+			Post("/provisioning/v1/services/" + serviceID + "/replication/external/" + action)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+// ResetExternalReplication stops inbound replication and removes its configuration.
+func (c *Client) ResetExternalReplication(ctx context.Context, serviceID string) error {
+	return c.doWithPendingRetry(ctx, func() error {
+		resp, err := c.HTTPClient.R().
+			SetHeader("Accept", "application/json").
+			SetContext(ctx).
+			SetError(&ErrorResponse{}).
+			Delete("/provisioning/v1/services/" + serviceID + "/replication/external")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return handleError(resp)
+		}
+
+		return nil
+	})
+}
+
+func (c *Client) CreateVPCPeering(ctx context.Context, req *provisioning.CreateVPCPeeringRequest) (*provisioning.VPCPeering, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(provisioning.VPCPeering{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		SetBody(req).
+		Post("/provisioning/v1/vpc-peerings")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*provisioning.VPCPeering), nil
+}
+
+func (c *Client) GetVPCPeeringByID(ctx context.Context, peeringID string) (*provisioning.VPCPeering, error) {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetResult(provisioning.VPCPeering{}).
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Get("/provisioning/v1/vpc-peerings/" + peeringID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, handleError(resp)
+	}
+	return resp.Result().(*provisioning.VPCPeering), nil
+}
+
+func (c *Client) DeleteVPCPeering(ctx context.Context, peeringID string) error {
+	resp, err := c.HTTPClient.R().
+		SetHeader("Accept", "application/json").
+		SetError(&ErrorResponse{}).
+		SetContext(ctx).
+		Delete("/provisioning/v1/vpc-peerings/" + peeringID)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return handleError(resp)
+	}
+	return nil
+}
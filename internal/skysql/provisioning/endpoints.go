@@ -2,10 +2,12 @@ package provisioning
 
 // ServiceEndpoint is service endpoint dto
 type ServiceEndpoint struct {
-	Mechanism       string   `json:"mechanism,omitempty"`
-	AllowedAccounts []string `json:"allowed_accounts,omitempty"`
-	Visibility      string   `json:"visibility"`
-	EndpointService string   `json:"endpoint_service,omitempty"`
+	Name            string          `json:"name,omitempty"`
+	Mechanism       string          `json:"mechanism,omitempty"`
+	AllowedAccounts []string        `json:"allowed_accounts,omitempty"`
+	Visibility      string          `json:"visibility"`
+	EndpointService string          `json:"endpoint_service,omitempty"`
+	AllowList       []AllowListItem `json:"allow_list,omitempty"`
 }
 
 // PatchServiceEndpointsRequest godoc
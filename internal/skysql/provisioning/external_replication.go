@@ -0,0 +1,36 @@
+package provisioning
+
+const (
+	ExternalReplicationStatusRunning = "running"
+	ExternalReplicationStatusStopped = "stopped"
+	ExternalReplicationStatusError   = "error"
+)
+
+// ExternalReplicationRequest configures a service to replicate from a MariaDB
+// or MySQL server outside of SkySQL.
+type ExternalReplicationRequest struct {
+	Host                string `json:"host"`
+	Port                int    `json:"port"`
+	Username            string `json:"username"`
+	Password            string `json:"password"`
+	GTIDPosition        string `json:"gtid_position,omitempty"`
+	SSLEnabled          bool   `json:"ssl_enabled"`
+	SSLCACertificate    string `json:"ssl_ca,omitempty"`
+	SSLCertificate      string `json:"ssl_cert,omitempty"`
+	SSLKey              string `json:"ssl_key,omitempty"`
+	SSLVerifyServerCert bool   `json:"ssl_verify_server_cert"`
+}
+
+// ExternalReplication is the inbound replication of a service. Credentials and
+// SSL keys are never returned.
+type ExternalReplication struct {
+	Host                string `json:"host"`
+	Port                int    `json:"port"`
+	Username            string `json:"username"`
+	GTIDPosition        string `json:"gtid_position,omitempty"`
+	SSLEnabled          bool   `json:"ssl_enabled"`
+	SSLVerifyServerCert bool   `json:"ssl_verify_server_cert"`
+	Status              string `json:"status"`
+	SecondsBehindMaster *int64 `json:"seconds_behind_master,omitempty"`
+	LastError           string `json:"last_error,omitempty"`
+}
@@ -0,0 +1,9 @@
+package provisioning
+
+// MaintenanceWindow is the weekly window in which SkySQL applies patches and restarts the service.
+type MaintenanceWindow struct {
+	DayOfWeek     string `json:"day_of_week"`
+	StartHour     int    `json:"start_hour"`
+	DurationHours int    `json:"duration_hours"`
+	Timezone      string `json:"timezone"`
+}
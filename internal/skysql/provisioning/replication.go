@@ -0,0 +1,29 @@
+package provisioning
+
+const (
+	// PromotionModePromote detaches the replica and makes it a standalone primary.
+	PromotionModePromote = "promote"
+	// PromotionModeSwitchover swaps roles: the replica becomes the primary and
+	// the former primary replicates from it.
+	PromotionModeSwitchover = "switchover"
+)
+
+// ReplicationPromotionRequest is the request body for POST /services/{id}/replication/promote.
+type ReplicationPromotionRequest struct {
+	Mode             string `json:"mode"`
+	PrimaryServiceID string `json:"primary_service_id"`
+}
+
+const (
+	ReplicationStateRunning    = "running"
+	ReplicationStateConnecting = "connecting"
+	ReplicationStateStopped    = "stopped"
+	ReplicationStateError      = "error"
+)
+
+// ReplicationStatus is the health of the replication of a replica service, GET /services/{id}/replication/status.
+type ReplicationStatus struct {
+	State      string `json:"state"`
+	LagSeconds *int64 `json:"lag_seconds,omitempty"`
+	LastError  string `json:"last_error,omitempty"`
+}
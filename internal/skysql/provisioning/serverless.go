@@ -0,0 +1,8 @@
+package provisioning
+
+// ServerlessSettings are the capacity limits and auto-pause delay of a serverless service.
+type ServerlessSettings struct {
+	MinCapacity           int  `json:"min_capacity"`
+	MaxCapacity           int  `json:"max_capacity"`
+	AutoPauseAfterMinutes *int `json:"auto_pause_after_minutes,omitempty"`
+}
@@ -3,6 +3,7 @@ package provisioning
 type Service struct {
 	ID            string     `json:"id"`
 	Name          string     `json:"name"`
+	ProjectID     string     `json:"project_id,omitempty"`
 	Region        string     `json:"region"`
 	Provider      string     `json:"provider"`
 	Tier          string     `json:"tier"`
@@ -26,16 +27,24 @@ type Service struct {
 		IOPS       int    `json:"iops"`
 		Throughput int    `json:"throughput"`
 	} `json:"storage_volume"`
-	OutboundIps        []string          `json:"outbound_ips"`
-	IsActive           bool              `json:"is_active"`
-	ServiceType        string            `json:"service_type"`
-	ReplicationEnabled bool              `json:"replication_enabled"`
-	PrimaryHost        string            `json:"primary_host"`
-	MaxscaleNodes      uint              `json:"maxscale_nodes,omitempty"`
-	MaxscaleSize       *string           `json:"maxscale_size,omitempty"`
-	AvailabilityZone   string            `json:"availability_zone,omitempty"`
-	Tags               map[string]string `json:"tags,omitempty"`
-	ConfigID           string            `json:"config_id,omitempty"`
+	OutboundIps        []string            `json:"outbound_ips"`
+	IsActive           bool                `json:"is_active"`
+	ServiceType        string              `json:"service_type"`
+	ReplicationEnabled bool                `json:"replication_enabled"`
+	PrimaryHost        string              `json:"primary_host"`
+	MaxscaleNodes      uint                `json:"maxscale_nodes,omitempty"`
+	MaxscaleSize       *string             `json:"maxscale_size,omitempty"`
+	AvailabilityZone   string              `json:"availability_zone,omitempty"`
+	Tags               map[string]string   `json:"tags,omitempty"`
+	ConfigID           string              `json:"config_id,omitempty"`
+	MaintenanceWindow  *MaintenanceWindow  `json:"maintenance_window,omitempty"`
+	Encryption         *Encryption         `json:"encryption,omitempty"`
+	Serverless         *ServerlessSettings `json:"serverless,omitempty"`
+}
+
+// Encryption is the customer-managed key the storage of a service is encrypted with.
+type Encryption struct {
+	KMSKeyID string `json:"kms_key_id"`
 }
 
 type Endpoint struct {
@@ -53,3 +62,9 @@ type Port struct {
 	Port    int    `json:"port"`
 	Purpose string `json:"purpose"`
 }
+
+const (
+	PortPurposeReadWrite = "readwrite"
+	PortPurposeReadOnly  = "readonly"
+	PortPurposeNoSQL     = "nosql"
+)
@@ -0,0 +1,5 @@
+package provisioning
+
+type NoSQLRequest struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,5 @@
+package provisioning
+
+type RestartServiceRequest struct {
+	Rolling bool `json:"rolling"`
+}
@@ -0,0 +1,5 @@
+package provisioning
+
+type UpdateServiceTierRequest struct {
+	Tier string `json:"tier"`
+}
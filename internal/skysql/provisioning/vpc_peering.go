@@ -0,0 +1,41 @@
+package provisioning
+
+const (
+	VPCPeeringStatusPending           = "pending"
+	VPCPeeringStatusPendingAcceptance = "pending_acceptance"
+	VPCPeeringStatusActive            = "active"
+	VPCPeeringStatusFailed            = "failed"
+)
+
+// CreateVPCPeeringRequest is the request body for POST /vpc-peerings.
+type CreateVPCPeeringRequest struct {
+	Name          string `json:"name"`
+	Provider      string `json:"provider"`
+	Region        string `json:"region"`
+	PeerVPCID     string `json:"peer_vpc_id,omitempty"`
+	PeerNetwork   string `json:"peer_network,omitempty"`
+	PeerAccountID string `json:"peer_account_id,omitempty"`
+	PeerProjectID string `json:"peer_project_id,omitempty"`
+	PeerCIDR      string `json:"peer_cidr"`
+}
+
+// VPCPeering is a peering connection between the SkySQL network of a region and a customer network.
+type VPCPeering struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Provider            string `json:"provider"`
+	Region              string `json:"region"`
+	PeerVPCID           string `json:"peer_vpc_id,omitempty"`
+	PeerNetwork         string `json:"peer_network,omitempty"`
+	PeerAccountID       string `json:"peer_account_id,omitempty"`
+	PeerProjectID       string `json:"peer_project_id,omitempty"`
+	PeerCIDR            string `json:"peer_cidr"`
+	Status              string `json:"status"`
+	StatusMessage       string `json:"status_message,omitempty"`
+	PeeringConnectionID string `json:"peering_connection_id,omitempty"`
+	SkySQLAccountID     string `json:"skysql_account_id,omitempty"`
+	SkySQLVPCID         string `json:"skysql_vpc_id,omitempty"`
+	SkySQLProjectID     string `json:"skysql_project_id,omitempty"`
+	SkySQLNetwork       string `json:"skysql_network,omitempty"`
+	SkySQLCIDR          string `json:"skysql_cidr,omitempty"`
+}